@@ -15,6 +15,8 @@ type alterTableActionAdd struct {
 	tableConstrain constraintTable
 	// table constraint using index
 	tableConstrainUsingIndex constraintTableUsingIndex
+	// identity sequence options
+	identity sequenceOptions
 }
 
 // Column add column
@@ -52,6 +54,11 @@ func (a *alterTableActionAdd) AsIdentity(options string) *alterTableActionAdd {
 	return a
 }
 
+// Identity get typed sequence options of identity
+func (a *alterTableActionAdd) Identity() *sequenceOptions {
+	return &a.identity
+}
+
 // TableConstraint return table constraint
 func (a *alterTableActionAdd) TableConstraint() *constraintTable {
 	return &a.tableConstrain
@@ -64,7 +71,7 @@ func (a *alterTableActionAdd) TableConstraintUsingIndex() *constraintTableUsingI
 
 // IsEmpty check if empty
 func (a *alterTableActionAdd) IsEmpty() bool {
-	return a == nil || a.ordered.IsEmpty() && a.column.IsEmpty() && a.tableConstrain.IsEmpty() && a.tableConstrainUsingIndex.IsEmpty()
+	return a == nil || a.ordered.IsEmpty() && a.column.IsEmpty() && a.tableConstrain.IsEmpty() && a.tableConstrainUsingIndex.IsEmpty() && a.identity.IsEmpty()
 }
 
 // Reset reset item data
@@ -99,5 +106,12 @@ func (a *alterTableActionAdd) String() string {
 	if !a.tableConstrainUsingIndex.IsEmpty() {
 		a.ordered.Add(0, a.ordered.Concat("ADD ", a.tableConstrainUsingIndex.String()))
 	}
+	if !a.identity.IsEmpty() {
+		if options := a.identity.render(sequenceIdentity); options != "" {
+			a.ordered.Add(1, a.ordered.Concat("AS IDENTITY (", options, ")"))
+		} else {
+			a.ordered.Add(1, a.ordered.Concat("AS IDENTITY"))
+		}
+	}
 	return a.ordered.String()
 }
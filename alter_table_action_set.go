@@ -89,6 +89,14 @@ func (a *alterTableActionSet) Sequence(order int, option string) *alterTableActi
 	return a
 }
 
+// SequenceOptions set typed sequence options of identity column
+func (a *alterTableActionSet) SequenceOptions(order int, options *sequenceOptions) *alterTableActionSet {
+	if option := options.alterColumnString(); option != "" {
+		a.ordered.Add(order, a.ordered.Concat(option))
+	}
+	return a
+}
+
 // Restart restart
 func (a *alterTableActionSet) Restart(order int, restart string) *alterTableActionSet {
 	a.ordered.Add(order, a.ordered.Concat("RESTART ", restart))
@@ -0,0 +1,205 @@
+package gosql
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+)
+
+// ErrNoChanges change set does not contain allowed changes
+var ErrNoChanges = errors.New("gosql: nothing changed")
+
+// OptionalValue value that can be unset, null or set
+type OptionalValue interface {
+	// IsSet value was provided
+	IsSet() bool
+	// IsNull value was provided as null
+	IsNull() bool
+	// Interface get value. Nil if null
+	Interface() any
+}
+
+// Optional value with unset and null states
+// Unset fields of PATCH request are not changed, null fields are set to NULL
+type Optional[T any] struct {
+	// value
+	value T
+	// is value provided
+	set bool
+	// is value null
+	null bool
+}
+
+// IsSet value was provided
+func (o Optional[T]) IsSet() bool {
+	return o.set
+}
+
+// IsNull value was provided as null
+func (o Optional[T]) IsNull() bool {
+	return o.set && o.null
+}
+
+// Get value and true if value is set and not null
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.set && !o.null
+}
+
+// Interface get value. Nil if null
+func (o Optional[T]) Interface() any {
+	if o.null {
+		return nil
+	}
+	return o.value
+}
+
+// IsZero value was not provided. Unset field is omitted from json with omitzero option
+func (o Optional[T]) IsZero() bool {
+	return !o.set
+}
+
+// MarshalJSON marshal value or null
+// Unset value is marshalled as null, use omitzero json option to omit it and keep it unset
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.set || o.null {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON mark value as set. Null json is set as null
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	o.set = true
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		o.null = true
+		return nil
+	}
+	o.null = false
+	return json.Unmarshal(data, &o.value)
+}
+
+// Some optional with value
+func Some[T any](value T) Optional[T] {
+	return Optional[T]{value: value, set: true}
+}
+
+// Null optional with null value
+func Null[T any]() Optional[T] {
+	return Optional[T]{set: true, null: true}
+}
+
+// ChangeSet ordered list of changed fields
+type ChangeSet struct {
+	// changed fields in order
+	fields []string
+	// values of fields. Nil is NULL
+	values map[string]any
+}
+
+// Set field value. Nil value is NULL
+func (c *ChangeSet) Set(field string, value any) *ChangeSet {
+	if c.values == nil {
+		c.values = make(map[string]any)
+	}
+	if _, ok := c.values[field]; !ok {
+		c.fields = append(c.fields, field)
+	}
+	c.values[field] = value
+	return c
+}
+
+// SetOptional set field value if optional is set
+func (c *ChangeSet) SetOptional(field string, value OptionalValue) *ChangeSet {
+	if value != nil && value.IsSet() {
+		c.Set(field, value.Interface())
+	}
+	return c
+}
+
+// Get field value
+func (c *ChangeSet) Get(field string) (value any, ok bool) {
+	value, ok = c.values[field]
+	return
+}
+
+// Len count of changed fields
+func (c *ChangeSet) Len() int {
+	if c == nil {
+		return 0
+	}
+	return len(c.fields)
+}
+
+// Fields changed fields in order
+func (c *ChangeSet) Fields() []string {
+	return c.fields
+}
+
+// Changed columns of changed fields according to allowed fields map
+// Map key is a field, value is a column
+func (c *ChangeSet) Changed(allowed map[string]string) []string {
+	var result = make([]string, 0, c.Len())
+	for _, field := range c.fields {
+		if column, ok := allowed[field]; ok {
+			result = append(result, column)
+		}
+	}
+	return result
+}
+
+// Apply set changed allowed columns to update query
+// Map key is a field, value is a column. Table qualifier of column is removed
+func (c *ChangeSet) Apply(u *Update, allowed map[string]string) error {
+	var changed bool
+	for _, field := range c.fields {
+		if column, ok := allowed[field]; ok {
+			u.Set().Append(unqualifiedColumn(column)+" = ?", c.values[field])
+			changed = true
+		}
+	}
+	if !changed {
+		return ErrNoChanges
+	}
+	return nil
+}
+
+// unqualifiedColumn remove table qualifier from column, postgres does not accept it in SET
+func unqualifiedColumn(column string) string {
+	if strings.HasSuffix(column, `"`) {
+		if i := strings.LastIndex(column, `."`); i >= 0 {
+			return column[i+1:]
+		}
+		return column
+	}
+	return column[strings.LastIndexByte(column, '.')+1:]
+}
+
+// NewChangeSet change set constructor
+func NewChangeSet() *ChangeSet {
+	return &ChangeSet{values: make(map[string]any)}
+}
+
+// ChangeSetFromStruct create change set from struct of OptionalValue fields
+// Field is a column name from db tag. Readonly and primary key fields are skipped
+func ChangeSetFromStruct(v any) (*ChangeSet, error) {
+	rv, err := structValue(v)
+	if err != nil {
+		return nil, err
+	}
+	c := NewChangeSet()
+	for _, field := range getMapFields(rv.Type()) {
+		if field.readOnly || field.pk {
+			continue
+		}
+		value := rv.FieldByIndex(field.index)
+		if value.Kind() == reflect.Pointer && value.IsNil() {
+			continue
+		}
+		if optional, ok := value.Interface().(OptionalValue); ok {
+			c.SetOptional(field.column, optional)
+		}
+	}
+	return c, nil
+}
@@ -0,0 +1,113 @@
+package gosql
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type patchUser struct {
+	Name  Optional[string] `json:"name" db:"name"`
+	Email Optional[string] `json:"email" db:"email"`
+	Age   Optional[int]    `json:"age" db:"age"`
+	Role  Optional[string] `json:"role" db:"role"`
+}
+
+func TestOptional(t *testing.T) {
+	var p patchUser
+	if err := json.Unmarshal([]byte(`{"name":"foo","email":null}`), &p); err != nil {
+		t.Fatal(err)
+	}
+	if name, ok := p.Name.Get(); !ok || name != "foo" {
+		t.Fatal("wrong set value")
+	}
+	if !p.Email.IsSet() || !p.Email.IsNull() {
+		t.Fatal("wrong null value")
+	}
+	if p.Age.IsSet() {
+		t.Fatal("wrong unset value")
+	}
+	data, err := json.Marshal(Some(10))
+	if err != nil || string(data) != "10" {
+		t.Fatal("wrong marshal")
+	}
+	// unset fields are omitted with omitzero, so round trip keeps them unset
+	type patch struct {
+		Name  Optional[string] `json:"name,omitzero"`
+		Email Optional[string] `json:"email,omitzero"`
+	}
+	data, err = json.Marshal(patch{Email: Null[string]()})
+	if err != nil || string(data) != `{"email":null}` {
+		t.Fatal("wrong omitzero marshal", string(data))
+	}
+	var round patch
+	if err = json.Unmarshal(data, &round); err != nil || round.Name.IsSet() || !round.Email.IsNull() {
+		t.Fatal("wrong round trip")
+	}
+}
+
+func TestChangeSet_Apply(t *testing.T) {
+	allowed := map[string]string{"name": "name", "email": "email", "age": "age"}
+	t.Run("from_struct", func(t *testing.T) {
+		p := patchUser{Name: Some("foo"), Email: Null[string](), Role: Some("admin")}
+		c, err := ChangeSetFromStruct(&p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		u := NewUpdate().Table("users")
+		u.Where().AddExpression("id = ?", 1)
+		if err = c.Apply(u, allowed); err != nil {
+			t.Fatal(err)
+		}
+		u.Returning().Add(c.Changed(allowed)...)
+		query, params, _ := u.SQL()
+		t.Log(query)
+		if query != "UPDATE users SET name = ?, email = ? WHERE (id = ?) RETURNING name, email;" {
+			t.Fatal("wrong change set update")
+		}
+		if len(params) != 3 || params[0] != "foo" || params[1] != nil || params[2] != 1 {
+			t.Fatal("wrong change set params")
+		}
+	})
+	t.Run("manual", func(t *testing.T) {
+		c := NewChangeSet().Set("age", 30).SetOptional("name", Optional[string]{}).Set("age", 31)
+		u := NewUpdate().Table("users")
+		if err := c.Apply(u, map[string]string{"age": "age"}); err != nil {
+			t.Fatal(err)
+		}
+		t.Log(u.String())
+		if u.String() != "UPDATE users SET age = ?;" || u.GetArguments()[0] != 31 {
+			t.Fatal("wrong manual change set")
+		}
+	})
+	t.Run("qualified", func(t *testing.T) {
+		c := NewChangeSet().Set("age", 31).Set("name", "foo")
+		u := NewUpdate().Table("users u")
+		if err := c.Apply(u, map[string]string{"age": "u.age", "name": `"u"."name"`}); err != nil {
+			t.Fatal(err)
+		}
+		t.Log(u.String())
+		if u.String() != `UPDATE users u SET age = ?, "name" = ?;` {
+			t.Fatal("wrong qualified change set")
+		}
+	})
+	t.Run("skip_readonly_pk", func(t *testing.T) {
+		type patchAccount struct {
+			ID      Optional[int]    `db:"id,pk"`
+			Created Optional[string] `db:"created_at,readonly"`
+			Name    Optional[string] `db:"name"`
+		}
+		c, err := ChangeSetFromStruct(patchAccount{ID: Some(1), Created: Some("now"), Name: Some("foo")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.Len() != 1 || c.Fields()[0] != "name" {
+			t.Fatal("readonly and pk fields must be skipped")
+		}
+	})
+	t.Run("nothing_changed", func(t *testing.T) {
+		c := NewChangeSet().Set("role", "admin")
+		if err := c.Apply(NewUpdate().Table("users"), allowed); err != ErrNoChanges {
+			t.Fatal("must be ErrNoChanges")
+		}
+	})
+}
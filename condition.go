@@ -81,6 +81,41 @@ func (c *Condition) Replace(cond *Condition) *Condition {
 	return c
 }
 
+// clone copy condition with own expressions and arguments
+func (c *Condition) clone() Condition {
+	cond := Condition{
+		operator:   c.operator,
+		expression: append([]string(nil), c.expression...),
+		argument:   append([]interface{}(nil), c.argument...),
+	}
+	if c.merge != nil {
+		cond.merge = &merge{operator: c.merge.operator, condition: append([]*Condition(nil), c.merge.condition...)}
+	}
+	return cond
+}
+
+// andCondition combine condition with AND condition without changing any of them
+// Condition with OR operator or merged conditions is wrapped, so AND applies to whole condition
+func andCondition(c *Condition, and *Condition) Condition {
+	if and.IsEmpty() {
+		return c.clone()
+	}
+	if c.IsEmpty() {
+		return and.clone()
+	}
+	if c.merge == nil && and.merge == nil && (c.operator == ConditionOperatorAnd || len(c.expression) == 1) &&
+		(and.operator == ConditionOperatorAnd || len(and.expression) == 1) {
+		cond := c.clone()
+		cond.operator = ConditionOperatorAnd
+		cond.expression = append(cond.expression, and.expression...)
+		cond.argument = append(cond.argument, and.argument...)
+		return cond
+	}
+	left, right := c.clone(), and.clone()
+	cond := Condition{operator: ConditionOperatorAnd}
+	return *cond.Merge(ConditionOperatorAnd, &left, &right)
+}
+
 // Merge with conditions
 func (c *Condition) Merge(operator string, conditions ...*Condition) *Condition {
 	for i := range conditions {
@@ -119,6 +119,16 @@ func (c *conflict) ResetConstraint() *conflict {
 	return c
 }
 
+// copyFrom replace conflict with src conflict
+func (c *conflict) copyFrom(src *conflict) *conflict {
+	c.object = src.object
+	c.action = src.action
+	c.constraint = src.constraint
+	c.set.copyFrom(&src.set)
+	c.where = src.where.clone()
+	return c
+}
+
 // NewConflict conflict constructor
 func NewConflict() *conflict {
 	return &conflict{
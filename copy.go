@@ -0,0 +1,384 @@
+package gosql
+
+import (
+	"io"
+	"strings"
+)
+
+const (
+	// CopyFormatText text format
+	CopyFormatText = "text"
+	// CopyFormatCSV csv format
+	CopyFormatCSV = "csv"
+	// CopyFormatBinary binary format
+	CopyFormatBinary = "binary"
+
+	// CopyStdin STDIN source
+	CopyStdin = "STDIN"
+	// CopyStdout STDOUT target
+	CopyStdout = "STDOUT"
+)
+
+// Copy query builder
+// COPY table_name [ ( column_name [, ...] ) ]
+//
+//	FROM { 'filename' | PROGRAM 'command' | STDIN }
+//	[ [ WITH ] ( option [, ...] ) ]
+//	[ WHERE condition ]
+//
+// COPY { table_name [ ( column_name [, ...] ) ] | ( query ) }
+//
+//	TO { 'filename' | PROGRAM 'command' | STDOUT }
+//	[ [ WITH ] ( option [, ...] ) ]
+//
+// where option can be one of:
+//
+//	FORMAT format_name
+//	FREEZE [ boolean ]
+//	DELIMITER 'delimiter_character'
+//	NULL 'null_string'
+//	DEFAULT 'default_string'
+//	HEADER [ boolean | MATCH ]
+//	QUOTE 'quote_character'
+//	ESCAPE 'escape_character'
+//	FORCE_QUOTE { ( column_name [, ...] ) | * }
+//	FORCE_NOT_NULL { ( column_name [, ...] ) | * }
+//	FORCE_NULL { ( column_name [, ...] ) | * }
+//	ENCODING 'encoding_name'
+//
+// Postgres does not accept bind parameters in COPY, so arguments of query and where condition are inlined as literals
+type Copy struct {
+	// table name
+	table string
+	// list of columns
+	columns expression
+	// source or target query
+	query *Select
+	// FROM or TO
+	direction string
+	// STDIN, STDOUT, file or program
+	target string
+	// FORMAT
+	format string
+	// FREEZE
+	freeze bool
+	// DELIMITER
+	delimiter string
+	// NULL
+	null *string
+	// DEFAULT
+	def *string
+	// HEADER
+	header string
+	// QUOTE
+	quote string
+	// ESCAPE
+	escape string
+	// FORCE_QUOTE
+	forceQuote detailedExpression
+	// FORCE_NOT_NULL
+	forceNotNull detailedExpression
+	// FORCE_NULL
+	forceNull detailedExpression
+	// ENCODING
+	encoding string
+	// where condition
+	where Condition
+}
+
+// Columns get columns
+func (c *Copy) Columns() *expression {
+	return &c.columns
+}
+
+// Where get condition
+func (c *Copy) Where() *Condition {
+	return &c.where
+}
+
+// File copy from or to file
+func (c *Copy) File(name string) *Copy {
+	c.target = quoteLiteral(name)
+	return c
+}
+
+// Program copy from or to program
+func (c *Copy) Program(command string) *Copy {
+	c.target = "PROGRAM " + quoteLiteral(command)
+	return c
+}
+
+// Format set format
+func (c *Copy) Format(format string) *Copy {
+	c.format = format
+	return c
+}
+
+// GetFormat get format
+func (c *Copy) GetFormat() string {
+	if c.format == "" {
+		return CopyFormatText
+	}
+	return c.format
+}
+
+// Freeze set freeze
+func (c *Copy) Freeze() *Copy {
+	c.freeze = true
+	return c
+}
+
+// Delimiter set delimiter
+func (c *Copy) Delimiter(delimiter string) *Copy {
+	c.delimiter = delimiter
+	return c
+}
+
+// Null set null string
+func (c *Copy) Null(null string) *Copy {
+	c.null = &null
+	return c
+}
+
+// Default set default string
+func (c *Copy) Default(def string) *Copy {
+	c.def = &def
+	return c
+}
+
+// Header set header
+func (c *Copy) Header() *Copy {
+	c.header = "HEADER"
+	return c
+}
+
+// HeaderMatch set header match
+func (c *Copy) HeaderMatch() *Copy {
+	c.header = "HEADER MATCH"
+	return c
+}
+
+// Quote set quote character
+func (c *Copy) Quote(quote string) *Copy {
+	c.quote = quote
+	return c
+}
+
+// Escape set escape character
+func (c *Copy) Escape(escape string) *Copy {
+	c.escape = escape
+	return c
+}
+
+// ForceQuote force quote columns. All columns if empty
+func (c *Copy) ForceQuote(column ...string) *Copy {
+	forceColumns(&c.forceQuote, column...)
+	return c
+}
+
+// ForceNotNull force not null columns. All columns if empty
+func (c *Copy) ForceNotNull(column ...string) *Copy {
+	forceColumns(&c.forceNotNull, column...)
+	return c
+}
+
+// ForceNull force null columns. All columns if empty
+func (c *Copy) ForceNull(column ...string) *Copy {
+	forceColumns(&c.forceNull, column...)
+	return c
+}
+
+// Encoding set encoding
+func (c *Copy) Encoding(encoding string) *Copy {
+	c.encoding = encoding
+	return c
+}
+
+// IsEmpty check if empty
+func (c *Copy) IsEmpty() bool {
+	return c == nil || (c.table == "" && c.query == nil)
+}
+
+// options render copy options
+func (c *Copy) options() string {
+	var options []string
+	if c.format != "" {
+		options = append(options, "FORMAT "+c.format)
+	}
+	if c.freeze {
+		options = append(options, "FREEZE")
+	}
+	if c.delimiter != "" {
+		options = append(options, "DELIMITER "+quoteLiteral(c.delimiter))
+	}
+	if c.null != nil {
+		options = append(options, "NULL "+quoteLiteral(*c.null))
+	}
+	if c.def != nil {
+		options = append(options, "DEFAULT "+quoteLiteral(*c.def))
+	}
+	if c.header != "" {
+		options = append(options, c.header)
+	}
+	if c.quote != "" {
+		options = append(options, "QUOTE "+quoteLiteral(c.quote))
+	}
+	if c.escape != "" {
+		options = append(options, "ESCAPE "+quoteLiteral(c.escape))
+	}
+	if !c.forceQuote.IsEmpty() {
+		options = append(options, "FORCE_QUOTE "+forceColumnsString(&c.forceQuote))
+	}
+	if !c.forceNotNull.IsEmpty() {
+		options = append(options, "FORCE_NOT_NULL "+forceColumnsString(&c.forceNotNull))
+	}
+	if !c.forceNull.IsEmpty() {
+		options = append(options, "FORCE_NULL "+forceColumnsString(&c.forceNull))
+	}
+	if c.encoding != "" {
+		options = append(options, "ENCODING "+quoteLiteral(c.encoding))
+	}
+	return strings.Join(options, ", ")
+}
+
+// Validate check arguments of query and where condition can be inlined
+func (c *Copy) Validate() error {
+	if c.query != nil {
+		if _, err := inlineArguments(c.query.String(), c.query.GetArguments()); err != nil {
+			return err
+		}
+	}
+	_, err := inlineArguments(c.where.String(), c.where.GetArguments())
+	return err
+}
+
+// String render copy query. Panics if arguments can not be inlined, use Validate to check
+func (c *Copy) String() string {
+	if c.IsEmpty() {
+		return ""
+	}
+	b := strings.Builder{}
+	b.WriteString("COPY ")
+	if c.query != nil {
+		query := mustInlineArguments(c.query.String(), c.query.GetArguments())
+		if c.query.SubQuery {
+			b.WriteString(query)
+		} else {
+			b.WriteString("(" + query + ")")
+		}
+	} else {
+		b.WriteString(c.table)
+		if c.columns.Len() > 0 {
+			b.WriteString(" (" + c.columns.String(", ") + ")")
+		}
+	}
+	b.WriteString(" " + c.direction + " " + c.target)
+	if options := c.options(); options != "" {
+		b.WriteString(" WITH (" + options + ")")
+	}
+	if !c.where.IsEmpty() && c.direction == "FROM" {
+		b.WriteString(" WHERE " + mustInlineArguments(c.where.String(), c.where.GetArguments()))
+	}
+	return b.String() + ";"
+}
+
+// SQL common sql interface. Arguments are inlined, so params are always empty
+func (c *Copy) SQL() (query string, params []any, returning []any) {
+	query = c.String()
+	return
+}
+
+// Encoder create encoder of rows according to copy format and options
+func (c *Copy) Encoder(w io.Writer) CopyEncoder {
+	switch c.GetFormat() {
+	case CopyFormatBinary:
+		return NewCopyBinaryEncoder(w)
+	case CopyFormatCSV:
+		e := NewCopyCSVEncoder(w)
+		if c.delimiter != "" {
+			e.delimiter = c.delimiter[0]
+		}
+		if c.quote != "" {
+			e.quote = c.quote[0]
+			e.escape = c.quote[0]
+		}
+		if c.escape != "" {
+			e.escape = c.escape[0]
+		}
+		if c.null != nil {
+			e.null = *c.null
+		}
+		columns := c.columns.Split()
+		if c.header != "" && c.columns.Len() > 0 {
+			e.header = columns
+		}
+		if !c.forceQuote.IsEmpty() {
+			if c.forceQuote.GetDetail() == "*" {
+				e.forceQuoteAll = true
+			} else {
+				e.forceQuote = make(map[int]bool)
+				for _, force := range c.forceQuote.Expression().Split() {
+					for i, column := range columns {
+						if column == force {
+							e.forceQuote[i] = true
+						}
+					}
+				}
+			}
+		}
+		return e
+	default:
+		e := NewCopyTextEncoder(w)
+		if c.delimiter != "" {
+			e.delimiter = c.delimiter[0]
+		}
+		if c.null != nil {
+			e.null = *c.null
+		}
+		if c.header != "" && c.columns.Len() > 0 {
+			e.header = c.columns.Split()
+		}
+		return e
+	}
+}
+
+// forceColumns set force columns. All columns if empty
+func forceColumns(force *detailedExpression, column ...string) {
+	if len(column) == 0 {
+		force.SetDetail("*")
+		return
+	}
+	force.Expression().Add(column...)
+}
+
+// forceColumnsString render force columns
+func forceColumnsString(force *detailedExpression) string {
+	if force.GetDetail() == "*" {
+		return "*"
+	}
+	return "(" + force.Expression().String(", ") + ")"
+}
+
+// quoteLiteral quote string literal
+func quoteLiteral(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// CopyFrom copy into table from stdin
+func CopyFrom(table string, columns ...string) *Copy {
+	c := &Copy{table: table, direction: "FROM", target: CopyStdin}
+	c.columns.Add(columns...)
+	return c
+}
+
+// CopyTo copy from table to stdout
+func CopyTo(table string, columns ...string) *Copy {
+	c := &Copy{table: table, direction: "TO", target: CopyStdout}
+	c.columns.Add(columns...)
+	return c
+}
+
+// CopyQueryTo copy query result to stdout
+func CopyQueryTo(query *Select) *Copy {
+	return &Copy{query: query, direction: "TO", target: CopyStdout}
+}
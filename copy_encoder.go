@@ -0,0 +1,397 @@
+package gosql
+
+import (
+	"bufio"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrCopyUnsupportedType value type can not be encoded in copy format
+var ErrCopyUnsupportedType = errors.New("gosql: unsupported type for copy encoder")
+
+// copyBinarySignature binary copy header signature
+var copyBinarySignature = []byte("PGCOPY\n\377\r\n\000")
+
+// postgres epoch for binary timestamps in microseconds
+var copyBinaryEpoch = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC).UnixMicro()
+
+// CopyEncoder encode rows in copy format
+type CopyEncoder interface {
+	// Encode write row
+	Encode(row []any) error
+	// Close write trailer and flush data
+	Close() error
+}
+
+// Check copy encoders for CopyEncoder
+var _ = CopyEncoder(&copyTextEncoder{})
+var _ = CopyEncoder(&copyCSVEncoder{})
+var _ = CopyEncoder(&copyBinaryEncoder{})
+
+// text copy format encoder
+type copyTextEncoder struct {
+	// writer
+	w *bufio.Writer
+	// delimiter
+	delimiter byte
+	// null string
+	null string
+	// header columns
+	header []string
+	// rows written
+	written bool
+}
+
+// Encode write row. Nothing is written if any value can not be encoded
+func (e *copyTextEncoder) Encode(row []any) error {
+	values, nulls, err := copyRowStrings(row)
+	if err != nil {
+		return err
+	}
+	if !e.written && len(e.header) > 0 {
+		for i, column := range e.header {
+			if i > 0 {
+				e.w.WriteByte(e.delimiter)
+			}
+			e.writeEscaped(column)
+		}
+		e.w.WriteByte('\n')
+	}
+	e.written = true
+	for i := range values {
+		if i > 0 {
+			e.w.WriteByte(e.delimiter)
+		}
+		if nulls[i] {
+			e.w.WriteString(e.null)
+			continue
+		}
+		e.writeEscaped(values[i])
+	}
+	return e.w.WriteByte('\n')
+}
+
+// writeEscaped write value with backslash escapes
+func (e *copyTextEncoder) writeEscaped(s string) {
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			e.w.WriteString(`\\`)
+		case '\n':
+			e.w.WriteString(`\n`)
+		case '\r':
+			e.w.WriteString(`\r`)
+		case '\t':
+			e.w.WriteString(`\t`)
+		case e.delimiter:
+			e.w.WriteByte('\\')
+			e.w.WriteByte(c)
+		default:
+			e.w.WriteByte(c)
+		}
+	}
+}
+
+// Close flush data
+func (e *copyTextEncoder) Close() error {
+	return e.w.Flush()
+}
+
+// NewCopyTextEncoder init text copy format encoder
+func NewCopyTextEncoder(w io.Writer) *copyTextEncoder {
+	return &copyTextEncoder{w: bufio.NewWriter(w), delimiter: '\t', null: `\N`}
+}
+
+// csv copy format encoder
+type copyCSVEncoder struct {
+	// writer
+	w *bufio.Writer
+	// delimiter
+	delimiter byte
+	// quote character
+	quote byte
+	// escape character
+	escape byte
+	// null string
+	null string
+	// header columns
+	header []string
+	// force quote columns by index
+	forceQuote map[int]bool
+	// force quote all columns
+	forceQuoteAll bool
+	// rows written
+	written bool
+}
+
+// Encode write row. Nothing is written if any value can not be encoded
+func (e *copyCSVEncoder) Encode(row []any) error {
+	values, nulls, err := copyRowStrings(row)
+	if err != nil {
+		return err
+	}
+	if !e.written && len(e.header) > 0 {
+		for i, column := range e.header {
+			if i > 0 {
+				e.w.WriteByte(e.delimiter)
+			}
+			e.writeField(column, false)
+		}
+		e.w.WriteByte('\n')
+	}
+	e.written = true
+	for i := range values {
+		if i > 0 {
+			e.w.WriteByte(e.delimiter)
+		}
+		if nulls[i] {
+			e.w.WriteString(e.null)
+			continue
+		}
+		e.writeField(values[i], e.forceQuoteAll || e.forceQuote[i])
+	}
+	return e.w.WriteByte('\n')
+}
+
+// writeField write quoted if needed field
+func (e *copyCSVEncoder) writeField(s string, force bool) {
+	if !force && s != "" && s != e.null && !strings.ContainsAny(s, string([]byte{e.delimiter, e.quote, '\r', '\n'})) {
+		e.w.WriteString(s)
+		return
+	}
+	e.w.WriteByte(e.quote)
+	for i := 0; i < len(s); i++ {
+		if s[i] == e.quote || s[i] == e.escape {
+			e.w.WriteByte(e.escape)
+		}
+		e.w.WriteByte(s[i])
+	}
+	e.w.WriteByte(e.quote)
+}
+
+// Close flush data
+func (e *copyCSVEncoder) Close() error {
+	return e.w.Flush()
+}
+
+// NewCopyCSVEncoder init csv copy format encoder
+func NewCopyCSVEncoder(w io.Writer) *copyCSVEncoder {
+	return &copyCSVEncoder{w: bufio.NewWriter(w), delimiter: ',', quote: '"', escape: '"'}
+}
+
+// binary copy format encoder
+type copyBinaryEncoder struct {
+	// writer
+	w *bufio.Writer
+	// header written
+	written bool
+	// value buffer
+	buf []byte
+}
+
+// writeHeader write signature, flags and header extension length
+func (e *copyBinaryEncoder) writeHeader() {
+	e.w.Write(copyBinarySignature)
+	e.w.Write(make([]byte, 8))
+	e.written = true
+}
+
+// Encode write row
+func (e *copyBinaryEncoder) Encode(row []any) error {
+	if !e.written {
+		e.writeHeader()
+	}
+	e.buf = appendUint16(e.buf[:0], uint16(len(row)))
+	for _, value := range row {
+		var err error
+		var null bool
+		start := len(e.buf)
+		e.buf = append(e.buf, 0, 0, 0, 0)
+		e.buf, null, err = copyBinaryValue(e.buf, value)
+		if err != nil {
+			return err
+		}
+		if null {
+			binary.BigEndian.PutUint32(e.buf[start:], math.MaxUint32)
+		} else {
+			binary.BigEndian.PutUint32(e.buf[start:], uint32(len(e.buf)-start-4))
+		}
+	}
+	_, err := e.w.Write(e.buf)
+	return err
+}
+
+// Close write trailer and flush data
+func (e *copyBinaryEncoder) Close() error {
+	if !e.written {
+		e.writeHeader()
+	}
+	e.w.Write([]byte{0xff, 0xff})
+	return e.w.Flush()
+}
+
+// NewCopyBinaryEncoder init binary copy format encoder
+func NewCopyBinaryEncoder(w io.Writer) *copyBinaryEncoder {
+	return &copyBinaryEncoder{w: bufio.NewWriter(w)}
+}
+
+// copyValue resolve driver valuer and pointers
+func copyValue(value any) (any, error) {
+	for {
+		switch v := value.(type) {
+		case nil:
+			return nil, nil
+		case time.Time:
+			return v, nil
+		case driver.Valuer:
+			rv := reflect.ValueOf(v)
+			if rv.Kind() == reflect.Pointer && rv.IsNil() {
+				return nil, nil
+			}
+			var err error
+			if value, err = v.Value(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		rv := reflect.ValueOf(value)
+		if rv.Kind() != reflect.Pointer {
+			return value, nil
+		}
+		if rv.IsNil() {
+			return nil, nil
+		}
+		value = rv.Elem().Interface()
+	}
+}
+
+// copyRowStrings convert all row values to strings before any of them is written
+func copyRowStrings(row []any) (values []string, nulls []bool, err error) {
+	values = make([]string, len(row))
+	nulls = make([]bool, len(row))
+	for i, value := range row {
+		values[i], nulls[i], err = copyValueString(value)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return
+}
+
+// copyValueString text representation of value
+func copyValueString(value any) (s string, null bool, err error) {
+	if value, err = copyValue(value); err != nil || value == nil {
+		return "", value == nil, err
+	}
+	switch v := value.(type) {
+	case string:
+		return v, false, nil
+	case []byte:
+		return `\x` + hex.EncodeToString(v), false, nil
+	case bool:
+		if v {
+			return "t", false, nil
+		}
+		return "f", false, nil
+	case int:
+		return strconv.FormatInt(int64(v), 10), false, nil
+	case int8:
+		return strconv.FormatInt(int64(v), 10), false, nil
+	case int16:
+		return strconv.FormatInt(int64(v), 10), false, nil
+	case int32:
+		return strconv.FormatInt(int64(v), 10), false, nil
+	case int64:
+		return strconv.FormatInt(v, 10), false, nil
+	case uint:
+		return strconv.FormatUint(uint64(v), 10), false, nil
+	case uint8:
+		return strconv.FormatUint(uint64(v), 10), false, nil
+	case uint16:
+		return strconv.FormatUint(uint64(v), 10), false, nil
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10), false, nil
+	case uint64:
+		return strconv.FormatUint(v, 10), false, nil
+	case float32:
+		return copyFloatString(float64(v), 32), false, nil
+	case float64:
+		return copyFloatString(v, 64), false, nil
+	case time.Time:
+		return v.Format("2006-01-02 15:04:05.999999999Z07:00"), false, nil
+	}
+	return "", false, ErrCopyUnsupportedType
+}
+
+// copyFloatString text representation of float
+func copyFloatString(v float64, bitSize int) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "Infinity"
+	case math.IsInf(v, -1):
+		return "-Infinity"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, bitSize)
+}
+
+// copyBinaryValue append binary representation of value
+// int is encoded as int8, string as text, []byte as bytea, [16]byte as uuid, time.Time as timestamptz
+func copyBinaryValue(buf []byte, value any) ([]byte, bool, error) {
+	value, err := copyValue(value)
+	if err != nil || value == nil {
+		return buf, value == nil, err
+	}
+	switch v := value.(type) {
+	case string:
+		return append(buf, v...), false, nil
+	case []byte:
+		return append(buf, v...), false, nil
+	case [16]byte:
+		return append(buf, v[:]...), false, nil
+	case bool:
+		if v {
+			return append(buf, 1), false, nil
+		}
+		return append(buf, 0), false, nil
+	case int16:
+		return appendUint16(buf, uint16(v)), false, nil
+	case int32:
+		return appendUint32(buf, uint32(v)), false, nil
+	case int64:
+		return appendUint64(buf, uint64(v)), false, nil
+	case int:
+		return appendUint64(buf, uint64(v)), false, nil
+	case float32:
+		return appendUint32(buf, math.Float32bits(v)), false, nil
+	case float64:
+		return appendUint64(buf, math.Float64bits(v)), false, nil
+	case time.Time:
+		return appendUint64(buf, uint64(v.UnixMicro()-copyBinaryEpoch)), false, nil
+	}
+	return buf, false, ErrCopyUnsupportedType
+}
+
+// appendUint16 append big endian uint16
+func appendUint16(buf []byte, v uint16) []byte {
+	return append(buf, byte(v>>8), byte(v))
+}
+
+// appendUint32 append big endian uint32
+func appendUint32(buf []byte, v uint32) []byte {
+	return append(buf, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+// appendUint64 append big endian uint64
+func appendUint64(buf []byte, v uint64) []byte {
+	return appendUint32(appendUint32(buf, uint32(v>>32)), uint32(v))
+}
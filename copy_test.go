@@ -0,0 +1,182 @@
+package gosql
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestCopy_String(t *testing.T) {
+	t.Run("from_stdin", func(t *testing.T) {
+		c := CopyFrom("country", "code", "name")
+		t.Log(c.String())
+		if c.String() != "COPY country (code, name) FROM STDIN;" {
+			t.Fatal("wrong from_stdin")
+		}
+	})
+	t.Run("from_stdin_csv", func(t *testing.T) {
+		c := CopyFrom("country", "code", "name").
+			Format(CopyFormatCSV).
+			Header().
+			Null("").
+			Delimiter(";").
+			ForceNotNull("name")
+		c.Where().AddExpression("code <> 'XX'")
+		t.Log(c.String())
+		if c.String() != "COPY country (code, name) FROM STDIN WITH (FORMAT csv, DELIMITER ';', NULL '', HEADER, FORCE_NOT_NULL (name)) WHERE (code <> 'XX');" {
+			t.Fatal("wrong from_stdin_csv")
+		}
+	})
+	t.Run("to_stdout", func(t *testing.T) {
+		c := CopyTo("country").Format(CopyFormatCSV).ForceQuote()
+		t.Log(c.String())
+		if c.String() != "COPY country TO STDOUT WITH (FORMAT csv, FORCE_QUOTE *);" {
+			t.Fatal("wrong to_stdout")
+		}
+	})
+	t.Run("query_to_file", func(t *testing.T) {
+		q := NewSelect().From("country")
+		q.Columns().Add("*")
+		q.Where().AddExpression("country_name LIKE 'A%'")
+		c := CopyQueryTo(q).File("/usr1/proj/bray/sql/a_list_countries.copy")
+		t.Log(c.String())
+		if c.String() != "COPY (SELECT * FROM country WHERE (country_name LIKE 'A%')) TO '/usr1/proj/bray/sql/a_list_countries.copy';" {
+			t.Fatal("wrong query_to_file")
+		}
+	})
+	t.Run("inline_arguments", func(t *testing.T) {
+		q := NewSelect().From("country")
+		q.Columns().Add("*")
+		q.Where().AddExpression("country_name LIKE ?", "A%")
+		c := CopyQueryTo(q).Format(CopyFormatCSV)
+		query, params, _ := c.SQL()
+		t.Log(query)
+		if query != "COPY (SELECT * FROM country WHERE (country_name LIKE 'A%')) TO STDOUT WITH (FORMAT csv);" || len(params) != 0 {
+			t.Fatal("wrong inline_arguments")
+		}
+		f := CopyFrom("country", "code")
+		f.Where().AddExpression("code <> ?", "XX")
+		if f.String() != "COPY country (code) FROM STDIN WHERE (code <> 'XX');" {
+			t.Fatal("wrong where inline_arguments")
+		}
+	})
+	t.Run("question_mark_not_placeholder", func(t *testing.T) {
+		q := NewSelect().From("country")
+		q.Columns().Add("*")
+		q.Where().AddExpression("data ? 'k'")
+		q.Where().AddExpression("note = 'why?'")
+		q.Where().AddExpression("code <> ?", "XX")
+		c := CopyQueryTo(q)
+		t.Log(c.String())
+		if c.Validate() != nil || c.String() != "COPY (SELECT * FROM country WHERE (data ? 'k' AND note = 'why?' AND code <> 'XX')) TO STDOUT;" {
+			t.Fatal("wrong question_mark_not_placeholder")
+		}
+	})
+	t.Run("invalid_arguments", func(t *testing.T) {
+		c := CopyFrom("country", "code")
+		c.Where().AddExpression("code <> ?", struct{}{})
+		if c.Validate() == nil {
+			t.Fatal("must be invalid")
+		}
+		defer func() {
+			if recover() == nil {
+				t.Fatal("must panic")
+			}
+		}()
+		_ = c.String()
+	})
+	t.Run("program", func(t *testing.T) {
+		c := CopyTo("country").Program("gzip > /usr1/proj/bray/sql/country_data.gz")
+		t.Log(c.String())
+		if c.String() != "COPY country TO PROGRAM 'gzip > /usr1/proj/bray/sql/country_data.gz';" {
+			t.Fatal("wrong program")
+		}
+	})
+}
+
+func TestCopyEncoder(t *testing.T) {
+	t.Run("text", func(t *testing.T) {
+		var buf bytes.Buffer
+		e := CopyFrom("t", "a", "b", "c", "d").Encoder(&buf)
+		name := "new\nline"
+		if err := e.Encode([]any{1, "tab\there", nil, &name}); err != nil {
+			t.Fatal(err)
+		}
+		if err := e.Encode([]any{true, `back\slash`, 1.5, []byte{0xde, 0xad}}); err != nil {
+			t.Fatal(err)
+		}
+		if err := e.Close(); err != nil {
+			t.Fatal(err)
+		}
+		t.Log(buf.String())
+		if buf.String() != "1\ttab\\there\t\\N\tnew\\nline\nt\tback\\\\slash\t1.5\t\\\\xdead\n" {
+			t.Fatal("wrong text encoding")
+		}
+	})
+	t.Run("text_header", func(t *testing.T) {
+		var buf bytes.Buffer
+		e := CopyFrom("t", "a", "b").Header().Encoder(&buf)
+		if err := e.Encode([]any{1, "x"}); err != nil {
+			t.Fatal(err)
+		}
+		if err := e.Close(); err != nil {
+			t.Fatal(err)
+		}
+		t.Log(buf.String())
+		if buf.String() != "a\tb\n1\tx\n" {
+			t.Fatal("wrong text header encoding")
+		}
+	})
+	t.Run("csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		e := CopyFrom("t", "a", "b", "c").Format(CopyFormatCSV).Header().ForceQuote("c").Encoder(&buf)
+		if err := e.Encode([]any{1, `say "hi", please`, "x"}); err != nil {
+			t.Fatal(err)
+		}
+		if err := e.Encode([]any{nil, "", time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}); err != nil {
+			t.Fatal(err)
+		}
+		if err := e.Close(); err != nil {
+			t.Fatal(err)
+		}
+		t.Log(buf.String())
+		if buf.String() != "a,b,c\n1,\"say \"\"hi\"\", please\",\"x\"\n,\"\",\"2024-01-02 03:04:05Z\"\n" {
+			t.Fatal("wrong csv encoding")
+		}
+	})
+	t.Run("binary", func(t *testing.T) {
+		var buf bytes.Buffer
+		e := CopyFrom("t", "a", "b", "c").Format(CopyFormatBinary).Encoder(&buf)
+		if err := e.Encode([]any{int32(1), "ab", nil}); err != nil {
+			t.Fatal(err)
+		}
+		if err := e.Close(); err != nil {
+			t.Fatal(err)
+		}
+		expected := append([]byte("PGCOPY\n\377\r\n\000"), 0, 0, 0, 0, 0, 0, 0, 0)
+		expected = append(expected, 0, 3)
+		expected = append(expected, 0, 0, 0, 4, 0, 0, 0, 1)
+		expected = append(expected, 0, 0, 0, 2, 'a', 'b')
+		expected = append(expected, 0xff, 0xff, 0xff, 0xff)
+		expected = append(expected, 0xff, 0xff)
+		if !bytes.Equal(buf.Bytes(), expected) {
+			t.Fatal("wrong binary encoding", buf.Bytes())
+		}
+	})
+	t.Run("unsupported", func(t *testing.T) {
+		var buf bytes.Buffer
+		e := NewCopyTextEncoder(&buf)
+		if err := e.Encode([]any{1, "a", []int{1}}); err != ErrCopyUnsupportedType {
+			t.Fatal("must be ErrCopyUnsupportedType")
+		}
+		c := NewCopyCSVEncoder(&buf)
+		if err := c.Encode([]any{1, "a", []int{1}}); err != ErrCopyUnsupportedType {
+			t.Fatal("must be ErrCopyUnsupportedType")
+		}
+		e.Close()
+		c.Close()
+		if buf.Len() != 0 {
+			t.Fatal("partial row must not be written")
+		}
+	})
+}
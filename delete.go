@@ -3,15 +3,29 @@ package gosql
 import "strings"
 
 // Delete query
+// [ WITH [ RECURSIVE ] with_query [, ...] ]
+// DELETE FROM [ ONLY ] table_name [ * ] [ [ AS ] alias ]
+//
+//	[ USING from_item [, ...] ]
+//	[ WHERE condition | WHERE CURRENT OF cursor_name ]
+//	[ RETURNING { * | output_expression [ [ AS ] output_name ] } [, ...] ]
 type Delete struct {
 	// with query
 	with with
 	// from
 	from string
+	// only table without descendant tables
+	only bool
+	// table alias
+	alias string
 	// using
-	using []string
+	using expression
 	// where condition
 	where Condition
+	// cursor name for WHERE CURRENT OF
+	cursor string
+	// version condition
+	version Condition
 	// returning
 	returning expression
 }
@@ -20,8 +34,10 @@ type Delete struct {
 func (d *Delete) IsEmpty() bool {
 	return d == nil || (d.with.Len() == 0 &&
 		d.from == "" &&
-		len(d.using) == 0 &&
+		d.using.Len() == 0 &&
 		d.where.IsEmpty() &&
+		d.version.IsEmpty() &&
+		d.cursor == "" &&
 		d.returning.Len() == 0)
 }
 
@@ -37,15 +53,33 @@ func (d *Delete) ResetFrom() *Delete {
 	return d
 }
 
+// Only delete from table without descendant tables
+func (d *Delete) Only() *Delete {
+	d.only = true
+	return d
+}
+
+// Alias set table alias
+func (d *Delete) Alias(alias string) *Delete {
+	d.alias = alias
+	return d
+}
+
 // Using add using
 func (d *Delete) Using(using ...string) *Delete {
-	d.using = append(d.using, using...)
+	d.using.Add(using...)
+	return d
+}
+
+// UsingExpression using item with arguments. Example: (SELECT id FROM t WHERE name = ?) AS s
+func (d *Delete) UsingExpression(using string, args ...any) *Delete {
+	d.using.Append(using, args...)
 	return d
 }
 
 // ResetUsing clear using
 func (d *Delete) ResetUsing() *Delete {
-	d.using = d.using[:0]
+	d.using.Reset()
 	return d
 }
 
@@ -54,19 +88,61 @@ func (d *Delete) Where() *Condition {
 	return &d.where
 }
 
+// CurrentOf delete row of cursor. Where condition is ignored
+func (d *Delete) CurrentOf(cursor string) *Delete {
+	d.cursor = cursor
+	return d
+}
+
+// WithVersion optimistic lock by version column
+func (d *Delete) WithVersion(column string, expected any) *Delete {
+	d.version = Condition{operator: ConditionOperatorAnd}
+	d.version.AddExpression(column+" = ?", expected)
+	return d
+}
+
+// condition where condition combined with version condition
+func (d *Delete) condition() Condition {
+	return andCondition(&d.where, &d.version)
+}
+
 // Returning Append returning expression
 func (d *Delete) Returning() *expression {
 	return &d.returning
 }
 
+// With Append with query
+func (d *Delete) With() *with {
+	return &d.with
+}
+
+// GetArguments get all values
+func (d *Delete) GetArguments() []any {
+	arguments := append(d.with.GetArguments(), d.using.GetArguments()...)
+	if d.cursor == "" {
+		where := d.condition()
+		arguments = append(arguments, where.GetArguments()...)
+	}
+	return arguments
+}
+
 // GetGetArguments get all values
+// Deprecated: use GetArguments
 func (d *Delete) GetGetArguments() []any {
-	return append(append(d.with.GetArguments(), d.where.GetArguments()...))
+	return d.GetArguments()
 }
 
 // SQL Get sql query
 func (d *Delete) SQL() (query string, params []any, returning []any) {
-	return d.String(), d.GetGetArguments(), d.returning.GetArguments()
+	return d.String(), d.GetArguments(), d.returning.GetArguments()
+}
+
+// reference table alias or name
+func (d *Delete) reference() string {
+	if d.alias != "" {
+		return d.alias
+	}
+	return tableReference(d.from)
 }
 
 // String return result query
@@ -80,13 +156,22 @@ func (d *Delete) String() string {
 	}
 	b.WriteString("DELETE")
 	if d.from != "" {
-		b.WriteString(" FROM " + d.from)
+		b.WriteString(" FROM ")
+		if d.only {
+			b.WriteString("ONLY ")
+		}
+		b.WriteString(d.from)
+		if d.alias != "" {
+			b.WriteString(" AS " + d.alias)
+		}
 	}
-	if len(d.using) > 0 {
-		b.WriteString(" USING " + strings.Join(d.using, ", "))
+	if d.using.Len() > 0 {
+		b.WriteString(" USING " + d.using.String(", "))
 	}
-	if !d.where.IsEmpty() {
-		b.WriteString(" WHERE " + d.where.String())
+	if d.cursor != "" {
+		b.WriteString(" WHERE CURRENT OF " + d.cursor)
+	} else if where := d.condition(); !where.IsEmpty() {
+		b.WriteString(" WHERE " + where.String())
 	}
 	if d.returning.Len() > 0 {
 		b.WriteString(" RETURNING " + d.returning.String(", "))
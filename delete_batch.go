@@ -0,0 +1,99 @@
+package gosql
+
+import (
+	"strconv"
+	"strings"
+)
+
+// DefaultBatchDeleteSize size of batch used when size is not positive
+const DefaultBatchDeleteSize = 1000
+
+// BatchDelete delete limited number of rows per query
+// Execute query until no rows affected to purge data without long locks
+//
+// DELETE FROM table_name WHERE ctid = ANY(ARRAY(SELECT ctid FROM table_name WHERE condition LIMIT size))
+//
+// DELETE FROM table_name WHERE ( key [, ...] ) IN (SELECT key [, ...] FROM table_name WHERE condition LIMIT size)
+type BatchDelete struct {
+	// table name
+	table string
+	// size of batch
+	size int
+	// key columns. ctid if empty
+	keys []string
+	// order of deleting
+	orders []string
+	// condition
+	where Condition
+	// returning
+	returning expression
+}
+
+// Key set key columns instead of ctid
+func (b *BatchDelete) Key(columns ...string) *BatchDelete {
+	b.keys = append(b.keys, columns...)
+	return b
+}
+
+// AddOrder add order of deleting. Example: created_at
+func (b *BatchDelete) AddOrder(expression ...string) *BatchDelete {
+	b.orders = append(b.orders, expression...)
+	return b
+}
+
+// Where condition
+func (b *BatchDelete) Where() *Condition {
+	return &b.where
+}
+
+// Returning get returning expression
+func (b *BatchDelete) Returning() *expression {
+	return &b.returning
+}
+
+// batchSize size of batch. DefaultBatchDeleteSize if size is not positive
+func (b *BatchDelete) batchSize() int {
+	if b.size <= 0 {
+		return DefaultBatchDeleteSize
+	}
+	return b.size
+}
+
+// Delete build delete query for one batch
+func (b *BatchDelete) Delete() *Delete {
+	sub := NewSelect().From(b.table)
+	if len(b.keys) == 0 {
+		sub.Columns().Add("ctid")
+	} else {
+		sub.Columns().Add(b.keys...)
+	}
+	sub.where = b.where.clone()
+	sub.AddOrder(b.orders...)
+	query := sub.String() + " LIMIT " + strconv.Itoa(b.batchSize())
+
+	d := NewDelete().From(b.table)
+	switch len(b.keys) {
+	case 0:
+		d.Where().AddExpression("ctid = ANY(ARRAY("+query+"))", sub.GetArguments()...)
+	case 1:
+		d.Where().AddExpression(b.keys[0]+" IN ("+query+")", sub.GetArguments()...)
+	default:
+		d.Where().AddExpression("("+strings.Join(b.keys, ", ")+") IN ("+query+")", sub.GetArguments()...)
+	}
+	d.returning.copyFrom(&b.returning)
+	return d
+}
+
+// SQL common sql interface
+func (b *BatchDelete) SQL() (query string, params []any, returning []any) {
+	return b.Delete().SQL()
+}
+
+// NewBatchDelete batch delete constructor
+// DefaultBatchDeleteSize is used if size is not positive
+func NewBatchDelete(table string, size int) *BatchDelete {
+	if size <= 0 {
+		size = DefaultBatchDeleteSize
+	}
+	return &BatchDelete{table: table, size: size, where: Condition{operator: ConditionOperatorAnd}}
+}
@@ -45,4 +45,85 @@ func TestDelete_String(t *testing.T) {
 			t.Fatal("wrong simple_2")
 		}
 	})
+
+	t.Run("only_alias", func(t *testing.T) {
+		d := NewDelete().From("films").Only().Alias("f")
+		d.Where().AddExpression("f.kind = ?", "Musical")
+		t.Log(d.String())
+		if d.String() != "DELETE FROM ONLY films AS f WHERE (f.kind = ?);" {
+			t.Fatal("wrong only_alias")
+		}
+	})
+
+	t.Run("using_sub_query", func(t *testing.T) {
+		sub := NewSelect()
+		sub.Columns().Add("id")
+		sub.From("producers")
+		sub.Where().AddExpression("name = ?", "foo")
+		sub.SubQuery = true
+
+		old := NewSelect().From("archive")
+		old.Columns().Add("id")
+		old.Where().AddExpression("year < ?", 1990)
+
+		d := NewDelete().From("films")
+		d.With().Add("old", old)
+		d.UsingExpression(sub.String()+" AS p", sub.GetArguments()...)
+		d.Where().AddExpression("producer_id = p.id AND kind = ?", "Drama")
+		query, params, _ := d.SQL()
+		t.Log(query)
+		if query != "WITH old AS (SELECT id FROM archive WHERE (year < ?)) DELETE FROM films USING (SELECT id FROM producers WHERE (name = ?)) AS p WHERE (producer_id = p.id AND kind = ?);" {
+			t.Fatal("wrong using_sub_query")
+		}
+		if len(params) != 3 || params[0] != 1990 || params[1] != "foo" || params[2] != "Drama" {
+			t.Fatal("wrong using_sub_query params")
+		}
+	})
+
+	t.Run("current_of", func(t *testing.T) {
+		d := NewDelete().From("tasks").CurrentOf("c_tasks")
+		d.Returning().Add("*")
+		t.Log(d.String())
+		if d.String() != "DELETE FROM tasks WHERE CURRENT OF c_tasks RETURNING *;" || len(d.GetArguments()) != 0 {
+			t.Fatal("wrong current_of")
+		}
+	})
+}
+
+func TestBatchDelete(t *testing.T) {
+	t.Run("ctid", func(t *testing.T) {
+		b := NewBatchDelete("events", 1000).AddOrder("created_at")
+		b.Where().AddExpression("created_at < ?", "2024-01-01")
+		query, params, _ := b.SQL()
+		t.Log(query)
+		if query != "DELETE FROM events WHERE (ctid = ANY(ARRAY(SELECT ctid FROM events WHERE (created_at < ?) ORDER BY created_at LIMIT 1000)));" || len(params) != 1 {
+			t.Fatal("wrong ctid batch delete")
+		}
+	})
+	t.Run("key", func(t *testing.T) {
+		b := NewBatchDelete("events", 10).Key("id")
+		b.Returning().Add("id")
+		t.Log(b.Delete().String())
+		if b.Delete().String() != "DELETE FROM events WHERE (id IN (SELECT id FROM events LIMIT 10)) RETURNING id;" {
+			t.Fatal("wrong key batch delete")
+		}
+	})
+	t.Run("default_size", func(t *testing.T) {
+		b := NewBatchDelete("events", 0)
+		t.Log(b.Delete().String())
+		if b.Delete().String() != "DELETE FROM events WHERE (ctid = ANY(ARRAY(SELECT ctid FROM events LIMIT 1000)));" {
+			t.Fatal("wrong default size batch delete")
+		}
+		if (&BatchDelete{table: "events", size: -1}).Delete().String() != "DELETE FROM events WHERE (ctid = ANY(ARRAY(SELECT ctid FROM events LIMIT 1000)));" {
+			t.Fatal("wrong negative size batch delete")
+		}
+	})
+	t.Run("composite_key", func(t *testing.T) {
+		b := NewBatchDelete("events", 10).Key("tenant_id", "id")
+		b.Where().AddExpression("tenant_id = ?", 5)
+		t.Log(b.Delete().String())
+		if b.Delete().String() != "DELETE FROM events WHERE ((tenant_id, id) IN (SELECT tenant_id, id FROM events WHERE (tenant_id = ?) LIMIT 10));" {
+			t.Fatal("wrong composite key batch delete")
+		}
+	})
 }
@@ -0,0 +1,96 @@
+package gosql
+
+import "strings"
+
+// Domain create domain query builder
+// CREATE DOMAIN name [ AS ] data_type
+//
+//	[ COLLATE collation ]
+//	[ DEFAULT expression ]
+//	[ constraint [ ... ] ]
+//
+// where constraint is:
+//
+// [ CONSTRAINT constraint_name ]
+// { NOT NULL | NULL | CHECK (expression) }
+type Domain struct {
+	// domain name
+	name string
+	// data type
+	dataType string
+	// collation
+	collate string
+	// default expression
+	def string
+	// not null
+	notNull bool
+	// check constraints
+	checks []string
+}
+
+// Collate set collation
+func (d *Domain) Collate(collation string) *Domain {
+	d.collate = collation
+	return d
+}
+
+// Default set default expression
+func (d *Domain) Default(expression string) *Domain {
+	d.def = expression
+	return d
+}
+
+// NotNull values can not be null
+func (d *Domain) NotNull() *Domain {
+	d.notNull = true
+	return d
+}
+
+// Check add check constraint. VALUE refers to checked value. Name is optional
+// Example: Check("positive", "VALUE > 0")
+func (d *Domain) Check(name string, expression string) *Domain {
+	if name != "" {
+		d.checks = append(d.checks, "CONSTRAINT "+name+" CHECK ("+expression+")")
+	} else {
+		d.checks = append(d.checks, "CHECK ("+expression+")")
+	}
+	return d
+}
+
+// IsEmpty check if empty
+func (d *Domain) IsEmpty() bool {
+	return d == nil || d.name == "" || d.dataType == ""
+}
+
+// String render create domain query
+func (d *Domain) String() string {
+	if d.IsEmpty() {
+		return ""
+	}
+	b := strings.Builder{}
+	b.WriteString("CREATE DOMAIN " + d.name + " AS " + d.dataType)
+	if d.collate != "" {
+		b.WriteString(" COLLATE " + d.collate)
+	}
+	if d.def != "" {
+		b.WriteString(" DEFAULT " + d.def)
+	}
+	if d.notNull {
+		b.WriteString(" NOT NULL")
+	}
+	if len(d.checks) > 0 {
+		b.WriteString(" " + strings.Join(d.checks, " "))
+	}
+	return b.String() + ";"
+}
+
+// SQL common sql interface
+func (d *Domain) SQL() (query string, params []any, returning []any) {
+	query = d.String()
+	return
+}
+
+// CreateDomain create domain constructor
+func CreateDomain(name string, dataType string) *Domain {
+	return &Domain{name: name, dataType: dataType}
+}
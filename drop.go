@@ -0,0 +1,173 @@
+package gosql
+
+import "strings"
+
+// Drop query builder
+// DROP { TABLE | VIEW | MATERIALIZED VIEW | SEQUENCE | TYPE | SCHEMA | EXTENSION | FUNCTION } [ IF EXISTS ] name [, ...] [ CASCADE | RESTRICT ]
+//
+// DROP INDEX [ CONCURRENTLY ] [ IF EXISTS ] name [, ...] [ CASCADE | RESTRICT ]
+//
+// DROP { TRIGGER | POLICY } [ IF EXISTS ] name ON table_name [ CASCADE | RESTRICT ]
+//
+// DROP ROLE [ IF EXISTS ] name [, ...]
+type Drop struct {
+	// object type
+	object string
+	// concurrently for index
+	concurrently bool
+	// if exists
+	ifExists bool
+	// object names
+	names expression
+	// table of trigger or policy
+	on string
+	// CASCADE | RESTRICT
+	option string
+}
+
+// Name add object names. Object dropped on table has single name, so name is replaced
+func (d *Drop) Name(name ...string) *Drop {
+	if d.on != "" && len(name) > 0 {
+		d.names.Reset()
+		d.names.Add(name[len(name)-1])
+		return d
+	}
+	d.names.Add(name...)
+	return d
+}
+
+// IfExists do not throw an error if object does not exist
+func (d *Drop) IfExists() *Drop {
+	d.ifExists = true
+	return d
+}
+
+// Concurrently drop index without locking concurrent queries
+func (d *Drop) Concurrently() *Drop {
+	d.concurrently = true
+	return d
+}
+
+// On set table of trigger or policy. Only last name is kept
+func (d *Drop) On(table string) *Drop {
+	d.on = table
+	if names := d.names.Split(); len(names) > 1 {
+		d.names.Reset()
+		d.names.Add(names[len(names)-1])
+	}
+	return d
+}
+
+// Cascade drop dependent objects
+func (d *Drop) Cascade() *Drop {
+	d.option = ActionCascade
+	return d
+}
+
+// Restrict refuse to drop if any objects depend on it
+func (d *Drop) Restrict() *Drop {
+	d.option = ActionRestrict
+	return d
+}
+
+// IsEmpty check if empty
+func (d *Drop) IsEmpty() bool {
+	return d == nil || d.object == "" || d.names.Len() == 0
+}
+
+// String render drop query
+func (d *Drop) String() string {
+	if d.IsEmpty() {
+		return ""
+	}
+	b := strings.Builder{}
+	b.WriteString("DROP " + d.object)
+	if d.concurrently {
+		b.WriteString(" CONCURRENTLY")
+	}
+	if d.ifExists {
+		b.WriteString(" IF EXISTS")
+	}
+	b.WriteString(" " + d.names.String(", "))
+	if d.on != "" {
+		b.WriteString(" ON " + d.on)
+	}
+	// drop role has no cascade or restrict option
+	if d.option != "" && d.object != "ROLE" {
+		b.WriteString(" " + d.option)
+	}
+	return b.String() + ";"
+}
+
+// SQL common sql interface
+func (d *Drop) SQL() (query string, params []any, returning []any) {
+	query = d.String()
+	return
+}
+
+// newDrop drop constructor
+func newDrop(object string, name ...string) *Drop {
+	d := &Drop{object: object}
+	d.names.Add(name...)
+	return d
+}
+
+// DropTable drop table constructor
+func DropTable(name ...string) *Drop {
+	return newDrop("TABLE", name...)
+}
+
+// DropIndex drop index constructor
+func DropIndex(name ...string) *Drop {
+	return newDrop("INDEX", name...)
+}
+
+// DropView drop view constructor
+func DropView(name ...string) *Drop {
+	return newDrop("VIEW", name...)
+}
+
+// DropMaterializedView drop materialized view constructor
+func DropMaterializedView(name ...string) *Drop {
+	return newDrop("MATERIALIZED VIEW", name...)
+}
+
+// DropSequence drop sequence constructor
+func DropSequence(name ...string) *Drop {
+	return newDrop("SEQUENCE", name...)
+}
+
+// DropType drop type constructor
+func DropType(name ...string) *Drop {
+	return newDrop("TYPE", name...)
+}
+
+// DropSchema drop schema constructor
+func DropSchema(name ...string) *Drop {
+	return newDrop("SCHEMA", name...)
+}
+
+// DropExtension drop extension constructor
+func DropExtension(name ...string) *Drop {
+	return newDrop("EXTENSION", name...)
+}
+
+// DropFunction drop function constructor. Name may contain argument types. Example: sqrt(integer)
+func DropFunction(name ...string) *Drop {
+	return newDrop("FUNCTION", name...)
+}
+
+// DropTrigger drop trigger constructor
+func DropTrigger(name string, table string) *Drop {
+	return newDrop("TRIGGER", name).On(table)
+}
+
+// DropPolicy drop policy constructor
+func DropPolicy(name string, table string) *Drop {
+	return newDrop("POLICY", name).On(table)
+}
+
+// DropRole drop role constructor
+func DropRole(name ...string) *Drop {
+	return newDrop("ROLE", name...)
+}
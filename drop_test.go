@@ -0,0 +1,59 @@
+package gosql
+
+import "testing"
+
+func TestDrop_String(t *testing.T) {
+	t.Run("table", func(t *testing.T) {
+		d := DropTable("films", "distributors").IfExists().Cascade()
+		t.Log(d.String())
+		if d.String() != "DROP TABLE IF EXISTS films, distributors CASCADE;" {
+			t.Fatal("wrong table")
+		}
+	})
+	t.Run("index", func(t *testing.T) {
+		d := DropIndex("title_idx").Concurrently().IfExists()
+		t.Log(d.String())
+		if d.String() != "DROP INDEX CONCURRENTLY IF EXISTS title_idx;" {
+			t.Fatal("wrong index")
+		}
+	})
+	t.Run("materialized_view", func(t *testing.T) {
+		d := DropMaterializedView("order_summary").Restrict()
+		t.Log(d.String())
+		if d.String() != "DROP MATERIALIZED VIEW order_summary RESTRICT;" {
+			t.Fatal("wrong materialized_view")
+		}
+	})
+	t.Run("function", func(t *testing.T) {
+		d := DropFunction("sqrt(integer)", "sqrt(bigint)")
+		t.Log(d.String())
+		if d.String() != "DROP FUNCTION sqrt(integer), sqrt(bigint);" {
+			t.Fatal("wrong function")
+		}
+	})
+	t.Run("trigger", func(t *testing.T) {
+		d := DropTrigger("if_dist_exists", "films").IfExists()
+		t.Log(d.String())
+		if d.String() != "DROP TRIGGER IF EXISTS if_dist_exists ON films;" {
+			t.Fatal("wrong trigger")
+		}
+		d.Name("a", "b")
+		if d.String() != "DROP TRIGGER IF EXISTS b ON films;" {
+			t.Fatal("trigger must have single name")
+		}
+	})
+	t.Run("other", func(t *testing.T) {
+		if DropView("kinds").String() != "DROP VIEW kinds;" ||
+			DropSequence("serial").String() != "DROP SEQUENCE serial;" ||
+			DropType("box").Name("mood").String() != "DROP TYPE box, mood;" ||
+			DropSchema("mystuff").Cascade().String() != "DROP SCHEMA mystuff CASCADE;" ||
+			DropExtension("hstore").IfExists().String() != "DROP EXTENSION IF EXISTS hstore;" {
+			t.Fatal("wrong other")
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		if DropTable().String() != "" {
+			t.Fatal("wrong empty")
+		}
+	})
+}
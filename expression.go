@@ -92,6 +92,16 @@ func (e *expression) Grow(n int) *expression {
 	return e
 }
 
+// copyFrom replace items and params with src expression
+func (e *expression) copyFrom(src *expression) *expression {
+	e.Reset()
+	if src.Len() > 0 {
+		e.list.WriteString(src.list.String())
+	}
+	e.params = append(e.params, src.GetArguments()...)
+	return e
+}
+
 // Split to slice of string
 func (e *expression) Split() []string {
 	return strings.SplitN(e.list.String(), EnumDelimiter, -1)
@@ -0,0 +1,277 @@
+package gosql
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	// ArgModeIn IN argument
+	ArgModeIn = "IN"
+	// ArgModeOut OUT argument
+	ArgModeOut = "OUT"
+	// ArgModeInOut INOUT argument
+	ArgModeInOut = "INOUT"
+	// ArgModeVariadic VARIADIC argument
+	ArgModeVariadic = "VARIADIC"
+
+	// FunctionImmutable IMMUTABLE
+	FunctionImmutable = "IMMUTABLE"
+	// FunctionStable STABLE
+	FunctionStable = "STABLE"
+	// FunctionVolatile VOLATILE
+	FunctionVolatile = "VOLATILE"
+
+	// ParallelUnsafe UNSAFE
+	ParallelUnsafe = "UNSAFE"
+	// ParallelRestricted RESTRICTED
+	ParallelRestricted = "RESTRICTED"
+	// ParallelSafe SAFE
+	ParallelSafe = "SAFE"
+)
+
+// functionArg function argument
+// [ argmode ] [ argname ] argtype [ { DEFAULT | = } default_expr ]
+type functionArg struct {
+	// mode
+	mode string
+	// name
+	name string
+	// type
+	dataType string
+	// default expression
+	def string
+}
+
+// Mode set argument mode
+func (a *functionArg) Mode(mode string) *functionArg {
+	a.mode = mode
+	return a
+}
+
+// Default set default expression
+func (a *functionArg) Default(expression string) *functionArg {
+	a.def = expression
+	return a
+}
+
+// String render argument
+func (a *functionArg) String() string {
+	b := strings.Builder{}
+	if a.mode != "" {
+		b.WriteString(a.mode + " ")
+	}
+	if a.name != "" {
+		b.WriteString(a.name + " ")
+	}
+	b.WriteString(a.dataType)
+	if a.def != "" {
+		b.WriteString(" DEFAULT " + a.def)
+	}
+	return b.String()
+}
+
+// Function create function or procedure query builder
+// CREATE [ OR REPLACE ] { FUNCTION | PROCEDURE } name ( [ [ argmode ] [ argname ] argtype [ { DEFAULT | = } default_expr ] [, ...] ] )
+//
+//	[ RETURNS rettype | RETURNS TABLE ( column_name column_type [, ...] ) ]
+//	{ LANGUAGE lang_name
+//	| { IMMUTABLE | STABLE | VOLATILE }
+//	| { CALLED ON NULL INPUT | RETURNS NULL ON NULL INPUT | STRICT }
+//	| { [ EXTERNAL ] SECURITY INVOKER | [ EXTERNAL ] SECURITY DEFINER }
+//	| PARALLEL { UNSAFE | RESTRICTED | SAFE }
+//	| SET configuration_parameter { TO value | = value | FROM CURRENT }
+//	| AS 'definition'
+//	} ...
+type Function struct {
+	// create procedure
+	procedure bool
+	// function name
+	name string
+	// or replace
+	orReplace bool
+	// arguments
+	args []*functionArg
+	// return type
+	returns string
+	// returns table columns
+	returnsTable expression
+	// language
+	language string
+	// IMMUTABLE | STABLE | VOLATILE
+	volatility string
+	// strict
+	strict bool
+	// security definer
+	securityDefiner bool
+	// parallel
+	parallel string
+	// configuration parameters
+	set expression
+	// body
+	body string
+}
+
+// OrReplace replace existing function
+func (f *Function) OrReplace() *Function {
+	f.orReplace = true
+	return f
+}
+
+// AddArg add argument. Name is optional
+func (f *Function) AddArg(name string, dataType string) *functionArg {
+	arg := &functionArg{name: name, dataType: dataType}
+	f.args = append(f.args, arg)
+	return arg
+}
+
+// Returns set return type
+func (f *Function) Returns(dataType string) *Function {
+	f.returns = dataType
+	return f
+}
+
+// ReturnsTable add returned table columns. Example: "id integer"
+func (f *Function) ReturnsTable(columns ...string) *Function {
+	f.returnsTable.Add(columns...)
+	return f
+}
+
+// Language set language. Example: plpgsql
+func (f *Function) Language(language string) *Function {
+	f.language = language
+	return f
+}
+
+// Volatility set volatility. FunctionImmutable, FunctionStable or FunctionVolatile
+func (f *Function) Volatility(volatility string) *Function {
+	f.volatility = volatility
+	return f
+}
+
+// Strict return null on null input
+func (f *Function) Strict() *Function {
+	f.strict = true
+	return f
+}
+
+// SecurityDefiner execute with privileges of owner
+func (f *Function) SecurityDefiner() *Function {
+	f.securityDefiner = true
+	return f
+}
+
+// Parallel set parallel mode. ParallelUnsafe, ParallelRestricted or ParallelSafe
+func (f *Function) Parallel(parallel string) *Function {
+	f.parallel = parallel
+	return f
+}
+
+// Set configuration parameter on call. Example: Set("search_path", "pg_catalog, pg_temp")
+func (f *Function) Set(parameter string, value string) *Function {
+	f.set.Add("SET " + parameter + " = " + value)
+	return f
+}
+
+// SetFromCurrent keep current configuration parameter on call
+func (f *Function) SetFromCurrent(parameter string) *Function {
+	f.set.Add("SET " + parameter + " FROM CURRENT")
+	return f
+}
+
+// Body set function body. Body is dollar quoted with tag not present in body
+func (f *Function) Body(body string) *Function {
+	f.body = body
+	return f
+}
+
+// IsEmpty check if empty
+func (f *Function) IsEmpty() bool {
+	return f == nil || f.name == ""
+}
+
+// String render create function query
+func (f *Function) String() string {
+	if f.IsEmpty() {
+		return ""
+	}
+	b := strings.Builder{}
+	b.WriteString("CREATE")
+	if f.orReplace {
+		b.WriteString(" OR REPLACE")
+	}
+	if f.procedure {
+		b.WriteString(" PROCEDURE ")
+	} else {
+		b.WriteString(" FUNCTION ")
+	}
+	b.WriteString(f.name + "(")
+	for i := range f.args {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(f.args[i].String())
+	}
+	b.WriteString(")")
+	if !f.procedure {
+		if f.returnsTable.Len() > 0 {
+			b.WriteString(" RETURNS TABLE (" + f.returnsTable.String(", ") + ")")
+		} else if f.returns != "" {
+			b.WriteString(" RETURNS " + f.returns)
+		}
+	}
+	if f.language != "" {
+		b.WriteString(" LANGUAGE " + f.language)
+	}
+	if !f.procedure {
+		if f.volatility != "" {
+			b.WriteString(" " + f.volatility)
+		}
+		if f.strict {
+			b.WriteString(" STRICT")
+		}
+	}
+	if f.securityDefiner {
+		b.WriteString(" SECURITY DEFINER")
+	}
+	if !f.procedure && f.parallel != "" {
+		b.WriteString(" PARALLEL " + f.parallel)
+	}
+	if f.set.Len() > 0 {
+		b.WriteString(" " + f.set.String(" "))
+	}
+	if f.body != "" {
+		tag := dollarQuoteTag(f.body)
+		b.WriteString(" AS " + tag + f.body + tag)
+	}
+	return b.String() + ";"
+}
+
+// SQL common sql interface
+func (f *Function) SQL() (query string, params []any, returning []any) {
+	query = f.String()
+	return
+}
+
+// CreateFunction create function constructor
+func CreateFunction(name string) *Function {
+	return &Function{name: name}
+}
+
+// CreateProcedure create procedure constructor
+func CreateProcedure(name string) *Function {
+	return &Function{name: name, procedure: true}
+}
+
+// dollarQuoteTag choose dollar quote tag that first occurs in body followed by tag at the end
+func dollarQuoteTag(body string) string {
+	tag := "$$"
+	for i := 0; strings.Index(body+tag, tag) != len(body); i++ {
+		if i == 0 {
+			tag = "$body$"
+		} else {
+			tag = "$body" + strconv.Itoa(i) + "$"
+		}
+	}
+	return tag
+}
@@ -0,0 +1,98 @@
+package gosql
+
+import "testing"
+
+func TestFunction_String(t *testing.T) {
+	t.Run("trigger_function", func(t *testing.T) {
+		f := CreateFunction("set_updated_at").OrReplace().
+			Returns("trigger").
+			Language("plpgsql").
+			Body(" BEGIN NEW.updated_at = now(); RETURN NEW; END; ")
+		t.Log(f.String())
+		if f.String() != "CREATE OR REPLACE FUNCTION set_updated_at() RETURNS trigger LANGUAGE plpgsql AS $$ BEGIN NEW.updated_at = now(); RETURN NEW; END; $$;" {
+			t.Fatal("wrong trigger_function")
+		}
+	})
+	t.Run("arguments", func(t *testing.T) {
+		f := CreateFunction("dup").Language("sql").Volatility(FunctionImmutable).Strict().
+			SecurityDefiner().Parallel(ParallelSafe).Set("search_path", "pg_catalog, pg_temp").
+			Body("SELECT $1, CAST($1 AS text) || ' is text'")
+		f.AddArg("", "int")
+		f.AddArg("f2", "int").Mode(ArgModeOut)
+		f.AddArg("f3", "text").Mode(ArgModeOut).Default("'x'")
+		t.Log(f.String())
+		if f.String() != "CREATE FUNCTION dup(int, OUT f2 int, OUT f3 text DEFAULT 'x') LANGUAGE sql IMMUTABLE STRICT SECURITY DEFINER PARALLEL SAFE SET search_path = pg_catalog, pg_temp AS $$SELECT $1, CAST($1 AS text) || ' is text'$$;" {
+			t.Fatal("wrong arguments")
+		}
+	})
+	t.Run("returns_table", func(t *testing.T) {
+		f := CreateFunction("tab").ReturnsTable("f1 int", "f2 text").Language("sql").Body("SELECT 1, 'a'")
+		t.Log(f.String())
+		if f.String() != "CREATE FUNCTION tab() RETURNS TABLE (f1 int, f2 text) LANGUAGE sql AS $$SELECT 1, 'a'$$;" {
+			t.Fatal("wrong returns_table")
+		}
+	})
+	t.Run("procedure", func(t *testing.T) {
+		p := CreateProcedure("insert_data").Language("sql").Volatility(FunctionStable).Body("INSERT INTO tbl VALUES (a);")
+		p.AddArg("a", "integer")
+		t.Log(p.String())
+		if p.String() != "CREATE PROCEDURE insert_data(a integer) LANGUAGE sql AS $$INSERT INTO tbl VALUES (a);$$;" {
+			t.Fatal("wrong procedure")
+		}
+	})
+	t.Run("dollar_quote_tag", func(t *testing.T) {
+		if dollarQuoteTag("SELECT 1") != "$$" ||
+			dollarQuoteTag("SELECT '$$'") != "$body$" ||
+			dollarQuoteTag("SELECT '$$', '$body$'") != "$body1$" ||
+			dollarQuoteTag("SELECT 1 $") != "$body$" ||
+			dollarQuoteTag("SELECT '$$' $body") != "$body1$" {
+			t.Fatal("wrong dollar_quote_tag")
+		}
+	})
+}
+
+func TestTrigger_String(t *testing.T) {
+	t.Run("updated_at", func(t *testing.T) {
+		tr := CreateTrigger("accounts_updated_at", "accounts").OrReplace().Before().OnUpdate().ForEachRow().Execute("set_updated_at")
+		tr.When().AddExpression("OLD.* IS DISTINCT FROM NEW.*")
+		t.Log(tr.String())
+		if tr.String() != "CREATE OR REPLACE TRIGGER accounts_updated_at BEFORE UPDATE ON accounts FOR EACH ROW WHEN (OLD.* IS DISTINCT FROM NEW.*) EXECUTE FUNCTION set_updated_at();" {
+			t.Fatal("wrong updated_at")
+		}
+	})
+	t.Run("transition_tables", func(t *testing.T) {
+		tr := CreateTrigger("paired_items_update", "paired_items").
+			OnUpdate("balance", "status").OnDelete().
+			ReferencingNew("newtab").ReferencingOld("oldtab").
+			ForEachStatement().
+			Execute("check_matching_pairs", "'audit'")
+		t.Log(tr.String())
+		if tr.String() != "CREATE TRIGGER paired_items_update AFTER UPDATE OF balance, status OR DELETE ON paired_items REFERENCING NEW TABLE AS newtab OLD TABLE AS oldtab FOR EACH STATEMENT EXECUTE FUNCTION check_matching_pairs('audit');" {
+			t.Fatal("wrong transition_tables")
+		}
+	})
+	t.Run("when_arguments", func(t *testing.T) {
+		tr := CreateTrigger("log_big", "orders").OnInsert().ForEachRow().Execute("log_order")
+		tr.When().AddExpression("NEW.total > ?", 1000)
+		t.Log(tr.String())
+		if tr.String() != "CREATE TRIGGER log_big AFTER INSERT ON orders FOR EACH ROW WHEN (NEW.total > 1000) EXECUTE FUNCTION log_order();" {
+			t.Fatal("wrong when_arguments")
+		}
+		tr = CreateTrigger("log_keyed", "orders").OnInsert().ForEachRow().Execute("log_order")
+		tr.When().AddExpression("NEW.data ? 'k'")
+		t.Log(tr.String())
+		if tr.Validate() != nil || tr.String() != "CREATE TRIGGER log_keyed AFTER INSERT ON orders FOR EACH ROW WHEN (NEW.data ? 'k') EXECUTE FUNCTION log_order();" {
+			t.Fatal("wrong jsonb when_arguments")
+		}
+		tr.When().AddExpression("NEW.items = ?", struct{}{})
+		if tr.Validate() != ErrInlineArgument {
+			t.Fatal("must be rejected")
+		}
+		defer func() {
+			if recover() != ErrInlineArgument {
+				t.Fatal("must panic with ErrInlineArgument")
+			}
+		}()
+		tr.SQL()
+	})
+}
@@ -0,0 +1,119 @@
+package gosql
+
+import (
+	"encoding/hex"
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInlineArgument argument can not be inlined into query
+var ErrInlineArgument = errors.New("gosql: argument can not be inlined as literal")
+
+// ErrInlineArgumentsCount count of placeholders is not equal to count of arguments
+var ErrInlineArgumentsCount = errors.New("gosql: placeholders count does not match arguments count")
+
+// inlineArguments replace placeholders with literals of arguments
+// Used for statements that can not be parameterized. Question marks in literals and jsonb operators are kept
+func inlineArguments(query string, args []any) (string, error) {
+	positions := placeholders(query)
+	if len(positions) != len(args) {
+		return "", ErrInlineArgumentsCount
+	}
+	if len(args) == 0 {
+		return query, nil
+	}
+	b := strings.Builder{}
+	var last int
+	for j, i := range positions {
+		literal, err := inlineLiteral(args[j])
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(query[last:i])
+		b.WriteString(literal)
+		last = i + 1
+	}
+	b.WriteString(query[last:])
+	return b.String(), nil
+}
+
+// mustInlineArguments replace placeholders with literals of arguments
+// Panics if arguments can not be inlined, because statement can not be rendered without them
+func mustInlineArguments(query string, args []any) string {
+	query, err := inlineArguments(query, args)
+	if err != nil {
+		panic(err)
+	}
+	return query
+}
+
+// inlineLiteral render argument as sql literal
+func inlineLiteral(value any) (string, error) {
+	value, err := copyValue(value)
+	if err != nil {
+		return "", err
+	}
+	switch v := value.(type) {
+	case nil:
+		return "NULL", nil
+	case string:
+		return escapeLiteral(v), nil
+	case []byte:
+		return escapeLiteral(`\x`+hex.EncodeToString(v)) + "::bytea", nil
+	case bool:
+		if v {
+			return "TRUE", nil
+		}
+		return "FALSE", nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		s, _, err := copyValueString(v)
+		return s, err
+	case float32:
+		return inlineFloat(float64(v), 32), nil
+	case float64:
+		return inlineFloat(v, 64), nil
+	case time.Time:
+		return quoteLiteral(v.Format("2006-01-02 15:04:05.999999999Z07:00")) + "::timestamptz", nil
+	}
+	return inlineArray(value)
+}
+
+// inlineArray render slice or array argument as array constructor. Empty array is untyped literal
+func inlineArray(value any) (string, error) {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return "", ErrInlineArgument
+	}
+	if rv.Len() == 0 {
+		return "'{}'", nil
+	}
+	items := make([]string, rv.Len())
+	for i := range items {
+		literal, err := inlineLiteral(rv.Index(i).Interface())
+		if err != nil {
+			return "", err
+		}
+		items[i] = literal
+	}
+	return "ARRAY[" + strings.Join(items, ", ") + "]", nil
+}
+
+// inlineFloat render float literal. Special values are quoted
+func inlineFloat(v float64, bitSize int) string {
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		return quoteLiteral(copyFloatString(v, bitSize)) + "::float8"
+	}
+	return strconv.FormatFloat(v, 'g', -1, bitSize)
+}
+
+// escapeLiteral quote string literal. Backslashes are escaped with E'' syntax
+func escapeLiteral(value string) string {
+	if !strings.Contains(value, `\`) {
+		return quoteLiteral(value)
+	}
+	return "E" + quoteLiteral(strings.ReplaceAll(value, `\`, `\\`))
+}
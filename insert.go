@@ -1,14 +1,32 @@
 package gosql
 
 import (
+	"errors"
 	"strings"
 )
 
+// PostgresMaxParams maximum number of bind parameters in one postgres query
+const PostgresMaxParams = 65535
+
+// ErrInsertArgumentsCount number of insert arguments is not a multiple of columns count
+var ErrInsertArgumentsCount = errors.New("gosql: insert arguments count is not a multiple of columns count")
+
+// ErrInsertPlaceholders number of placeholders in raw value is not equal to number of its arguments
+var ErrInsertPlaceholders = errors.New("gosql: insert row placeholders count does not match arguments count")
+
+// ErrInsertParamsLimit one row of insert does not fit in params limit
+var ErrInsertParamsLimit = errors.New("gosql: insert row does not fit in params limit")
+
 const (
 	// ConflictActionNothing On conflict action do nothing
 	ConflictActionNothing = "NOTHING"
 	// ConflictActionUpdate On conflict action do update nothing
 	ConflictActionUpdate = "UPDATE"
+
+	// OverridingSystemValue OVERRIDING SYSTEM VALUE
+	OverridingSystemValue = "SYSTEM VALUE"
+	// OverridingUserValue OVERRIDING USER VALUE
+	OverridingUserValue = "USER VALUE"
 )
 
 // Insert query builder
@@ -19,10 +37,22 @@ type Insert struct {
 	into string
 	// from insert
 	from []string
+	// select query source
+	query *Select
+	// values source
+	values *Values
 	// list of columns
 	columns expression
+	// OVERRIDING
+	overriding string
+	// DEFAULT VALUES
+	defaultValues bool
 	// conflict expression
 	conflict conflict
+	// upsert options
+	upsert *upsert
+	// unnest options
+	unnest *unnest
 	// returning
 	returning expression
 }
@@ -36,33 +66,44 @@ func (i *Insert) String() string {
 	if i.into != "" {
 		b.WriteString("INSERT INTO " + i.into)
 	}
-	if i.columns.Len() > 0 {
+	if i.columns.Len() > 0 && !i.defaultValues {
 		b.WriteString(" (" + i.columns.String(", ") + ")")
 	}
-	if len(i.from) > 0 {
+	if i.overriding != "" {
+		b.WriteString(" OVERRIDING " + i.overriding)
+	}
+	if i.defaultValues {
+		b.WriteString(" DEFAULT VALUES")
+	} else if len(i.from) > 0 {
 		b.WriteString(" " + strings.Join(i.from, ", "))
+	} else if i.query != nil {
+		b.WriteString(" " + i.query.String())
+	} else if !i.values.IsEmpty() {
+		b.WriteString(" " + i.values.String())
+	} else if i.isUnnest() {
+		b.WriteString(" " + i.unnest.String(i.columns.Split()))
 	} else if i.columns.ArgLen() > 0 {
 		b.WriteString(" VALUES ")
 		cols := len(i.columns.Split())
-		for j := 1; j <= i.columns.ArgLen(); {
-			b.WriteString("(")
-			for u := 0; u < cols; u++ {
-				b.WriteString("?")
-				j++
-				if u == cols-1 {
-					break
+		args := i.columns.GetArguments()
+		for j, arg := range args {
+			if j%cols == 0 {
+				if j > 0 {
+					b.WriteString(", ")
 				}
+				b.WriteString("(")
+			} else {
 				b.WriteString(", ")
 			}
-			b.WriteString(")")
-			if j >= i.columns.ArgLen() {
-				break
+			cell, _ := valueCell(arg)
+			b.WriteString(cell)
+			if j%cols == cols-1 || j == len(args)-1 {
+				b.WriteString(")")
 			}
-			b.WriteString(", ")
 		}
 	}
 	if !i.conflict.IsEmpty() {
-		b.WriteString(" " + i.Conflict().String())
+		b.WriteString(" " + i.conflictClause().String())
 	}
 	if i.returning.Len() > 0 {
 		b.WriteString(" RETURNING " + i.returning.String(", "))
@@ -75,7 +116,8 @@ func (i *Insert) String() string {
 func (i *Insert) IsEmpty() bool {
 	return i == nil || (i.with.Len() == 0 &&
 		i.into == "" &&
-		len(i.from) == 0 &&
+		!i.hasSource() &&
+		i.overriding == "" &&
 		i.columns.Len() == 0 &&
 		i.columns.ArgLen() == 0 &&
 		i.returning.Len() == 0 &&
@@ -89,7 +131,106 @@ func (i *Insert) SQL() (query string, params []any, returning []any) {
 
 // GetArguments get all arguments
 func (i *Insert) GetArguments() []any {
-	return append(append(i.with.GetArguments(), i.columns.GetArguments()...), i.conflict.GetArguments()...)
+	params := i.with.GetArguments()
+	if i.query != nil {
+		params = append(params, i.query.GetArguments()...)
+	} else if !i.values.IsEmpty() {
+		params = append(params, i.values.GetArguments()...)
+	} else if i.isUnnest() {
+		params = append(params, i.unnest.GetArguments(i.columns.Split(), i.columns.GetArguments())...)
+	} else if !i.defaultValues {
+		params = append(params, valueArguments(i.columns.GetArguments())...)
+	}
+	return append(params, i.conflictClause().GetArguments()...)
+}
+
+// hasSource check if insert rows come from query, values or from expression
+func (i *Insert) hasSource() bool {
+	return i.defaultValues || len(i.from) > 0 || i.query != nil || !i.values.IsEmpty()
+}
+
+// Validate check that insert arguments fill rows of values
+func (i *Insert) Validate() error {
+	if i.hasSource() || i.columns.ArgLen() == 0 {
+		return nil
+	}
+	if i.columns.Len() == 0 || i.columns.ArgLen()%len(i.columns.Split()) != 0 {
+		return ErrInsertArgumentsCount
+	}
+	if i.unnest != nil {
+		return i.unnest.Validate(i.columns.Split(), i.columns.GetArguments())
+	}
+	for _, arg := range i.columns.GetArguments() {
+		if raw, ok := arg.(RawValue); ok {
+			if !raw.IsValid() {
+				return ErrInsertPlaceholders
+			}
+		}
+	}
+	return nil
+}
+
+// Batches split insert rows into queries with number of params not greater than maxParams
+// Each batch keeps with, conflict and returning clauses
+// If maxParams <= 0 PostgresMaxParams is used
+func (i *Insert) Batches(maxParams int) ([]*Insert, error) {
+	if err := i.Validate(); err != nil {
+		return nil, err
+	}
+	if maxParams <= 0 {
+		maxParams = PostgresMaxParams
+	}
+	if len(i.GetArguments()) <= maxParams || i.hasSource() || i.isUnnest() {
+		return []*Insert{i}, nil
+	}
+	columns := i.columns.Split()
+	limit := maxParams - len(i.with.GetArguments()) - len(i.conflictClause().GetArguments())
+	args := i.columns.GetArguments()
+	batches := make([]*Insert, 0, 2)
+	for start := 0; start < len(args); {
+		// collect rows while arguments fit in limit
+		var params int
+		end := start
+		for end < len(args) {
+			rowParams := len(valueArguments(args[end : end+len(columns)]))
+			if params+rowParams > limit {
+				break
+			}
+			params += rowParams
+			end += len(columns)
+		}
+		if end == start {
+			return nil, ErrInsertParamsLimit
+		}
+		batch := NewInsert().Into(i.into).Overriding(i.overriding)
+		batch.with.copyFrom(&i.with)
+		batch.columns.Add(columns...)
+		batch.columns.Arg(args[start:end]...)
+		batch.conflict.copyFrom(&i.conflict)
+		batch.upsert = i.upsert
+		batch.returning.copyFrom(&i.returning)
+		batches = append(batches, batch)
+		start = end
+	}
+	return batches, nil
+}
+
+// Overriding set OVERRIDING { SYSTEM | USER } VALUE
+func (i *Insert) Overriding(overriding string) *Insert {
+	i.overriding = overriding
+	return i
+}
+
+// DefaultValues insert row of default values. Column list is not rendered
+func (i *Insert) DefaultValues() *Insert {
+	i.defaultValues = true
+	return i
+}
+
+// ResetDefaultValues reset default values
+func (i *Insert) ResetDefaultValues() *Insert {
+	i.defaultValues = false
+	return i
 }
 
 // SetConflict set conflict
@@ -106,9 +247,91 @@ func (i *Insert) Conflict() *conflict {
 // ResetConflict reset conflict expression
 func (i *Insert) ResetConflict() *Insert {
 	i.conflict = conflict{}
+	i.upsert = nil
+	return i
+}
+
+// Upsert update inserted columns except conflict columns on conflict
+// Set list is derived from columns on render. Conflict columns or constraint are required, DO NOTHING is rendered without them
+func (i *Insert) Upsert(conflictColumns ...string) *upsert {
+	i.conflict.Object(strings.Join(conflictColumns, ", ")).Action(ConflictActionUpdate)
+	i.upsert = &upsert{conflictColumns: conflictColumns}
+	return i.upsert
+}
+
+// Unnest insert rows as column arrays with single parameter per column
+// Arrays are cast to types of columns. Example: map[string]string{"id": "int"} renders ?::int[]
+// Type is required for every column. Rows are rendered as values list if type is missing or raw value passed
+func (i *Insert) Unnest(types map[string]string) *unnest {
+	i.unnest = &unnest{types: types}
+	return i.unnest
+}
+
+// isUnnest check if rows are rendered as unnest column arrays
+func (i *Insert) isUnnest() bool {
+	return i.unnest != nil && i.columns.ArgLen() > 0 && i.unnest.Validate(i.columns.Split(), i.columns.GetArguments()) == nil
+}
+
+// ResetUnnest insert rows as values
+func (i *Insert) ResetUnnest() *Insert {
+	i.unnest = nil
 	return i
 }
 
+// conflictClause get conflict with derived upsert set and condition
+func (i *Insert) conflictClause() *conflict {
+	if i.upsert == nil {
+		return &i.conflict
+	}
+	c := NewConflict()
+	if i.conflict.object == "" && i.conflict.constraint == "" {
+		c.action = ConflictActionNothing
+		return c
+	}
+	c.object = i.conflict.object
+	c.constraint = i.conflict.constraint
+	c.action = i.conflict.action
+	target := tableReference(i.into)
+	distinct := NewSqlCondition(ConditionOperatorOr)
+	for _, column := range i.columns.Split() {
+		column = strings.TrimSpace(column)
+		if column == "" || i.upsert.isExcluded(column) {
+			continue
+		}
+		c.set.Add(column + " = EXCLUDED." + column)
+		if i.upsert.onlyChanged {
+			distinct.AddExpression(target + "." + column + " IS DISTINCT FROM EXCLUDED." + column)
+		}
+	}
+	if i.upsert.set.Len() > 0 {
+		c.set.Append(i.upsert.set.String(EnumDelimiter), i.upsert.set.GetArguments()...)
+	}
+	if i.conflict.set.Len() > 0 {
+		c.set.Append(i.conflict.set.String(EnumDelimiter), i.conflict.set.GetArguments()...)
+	}
+	if c.set.Len() == 0 {
+		c.action = ConflictActionNothing
+	}
+	switch {
+	case distinct.IsEmpty():
+		c.where = i.conflict.where
+	case i.conflict.where.IsEmpty():
+		c.where = *distinct
+	default:
+		c.where.Merge(ConditionOperatorAnd, &i.conflict.where, distinct)
+	}
+	return c
+}
+
+// tableReference get alias or name of table expression
+func tableReference(table string) string {
+	parts := strings.Fields(table)
+	if len(parts) == 0 {
+		return ""
+	}
+	return parts[len(parts)-1]
+}
+
 // Returning Get returning expression
 func (i *Insert) Returning() *expression {
 	return &i.returning
@@ -120,9 +343,23 @@ func (i *Insert) From(from ...string) *Insert {
 	return i
 }
 
-// ResetFrom clear from
+// FromSelect insert rows returned by select query
+func (i *Insert) FromSelect(query *Select) *Insert {
+	i.query = query
+	return i
+}
+
+// FromValues insert rows of values
+func (i *Insert) FromValues(values *Values) *Insert {
+	i.values = values
+	return i
+}
+
+// ResetFrom clear from, select and values sources
 func (i *Insert) ResetFrom() *Insert {
 	i.from = i.from[:0]
+	i.query = nil
+	i.values = nil
 	return i
 }
 
@@ -148,6 +385,50 @@ func (i *Insert) With() *with {
 	return &i.with
 }
 
+// upsert options
+type upsert struct {
+	// conflict columns
+	conflictColumns []string
+	// excluded from update columns
+	exclude []string
+	// update only changed rows
+	onlyChanged bool
+	// extra set expressions
+	set expression
+}
+
+// Exclude columns from update
+func (u *upsert) Exclude(columns ...string) *upsert {
+	u.exclude = append(u.exclude, columns...)
+	return u
+}
+
+// OnlyChanged update row only if any of updated values is distinct from existing
+func (u *upsert) OnlyChanged() *upsert {
+	u.onlyChanged = true
+	return u
+}
+
+// Set extra set expressions. Example: updated_at = now()
+func (u *upsert) Set() *expression {
+	return &u.set
+}
+
+// isExcluded check if column is not updated
+func (u *upsert) isExcluded(column string) bool {
+	for _, c := range u.conflictColumns {
+		if c == column {
+			return true
+		}
+	}
+	for _, c := range u.exclude {
+		if c == column {
+			return true
+		}
+	}
+	return false
+}
+
 // NewInsert new insert query builder
 func NewInsert() *Insert {
 	return &Insert{
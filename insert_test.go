@@ -107,3 +107,320 @@ func TestInsert_String(t *testing.T) {
 		}
 	})
 }
+
+func TestInsert_Batches(t *testing.T) {
+	t.Run("split", func(t *testing.T) {
+		i := NewInsert().Into("distributors")
+		i.Columns().Add("did", "dname")
+		for j := 0; j < 5; j++ {
+			i.Columns().Arg(j, "name")
+		}
+		i.Conflict().Object("did").Action(ConflictActionNothing)
+		i.Returning().Add("did")
+		batches, err := i.Batches(4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(batches) != 3 {
+			t.Fatal("wrong batches count")
+		}
+		for _, b := range batches {
+			t.Log(b.String())
+		}
+		if batches[0].String() != "INSERT INTO distributors (did, dname) VALUES (?, ?), (?, ?) ON CONFLICT (did) DO NOTHING RETURNING did;" {
+			t.Fatal("wrong first batch")
+		}
+		if batches[2].String() != "INSERT INTO distributors (did, dname) VALUES (?, ?) ON CONFLICT (did) DO NOTHING RETURNING did;" {
+			t.Fatal("wrong last batch")
+		}
+		if len(batches[1].GetArguments()) != 4 || batches[1].GetArguments()[0] != 2 {
+			t.Fatal("wrong batch arguments")
+		}
+	})
+	t.Run("conflict_args", func(t *testing.T) {
+		i := NewInsert().Into("distributors")
+		i.Columns().Add("did", "dname")
+		i.Columns().Arg(1, "a", 2, "b", 3, "c")
+		i.Conflict().Object("did").Action(ConflictActionUpdate).Set().Append("dname = ?", "x")
+		batches, err := i.Batches(5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(batches) != 2 || len(batches[0].GetArguments()) != 5 || batches[0].GetArguments()[4] != "x" {
+			t.Fatal("wrong batches with conflict arguments")
+		}
+	})
+	t.Run("conflict_where", func(t *testing.T) {
+		i := NewInsert().Into("distributors")
+		i.Columns().Add("did", "dname")
+		i.Columns().Arg(1, "a", 2, "b")
+		i.Conflict().Object("did").Action(ConflictActionUpdate).Set().Add("dname = EXCLUDED.dname")
+		i.Conflict().Where().Replace(NewSqlCondition(ConditionOperatorAnd)).AddExpression("distributors.active")
+		batches, err := i.Batches(2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		batches[0].Conflict().Where().AddExpression("distributors.did <> ?", 1)
+		batches[1].Conflict().Where().AddExpression("distributors.did <> ?", 2)
+		t.Log(batches[0].String())
+		if batches[0].String() != "INSERT INTO distributors (did, dname) VALUES (?, ?) ON CONFLICT (did) DO UPDATE SET dname = EXCLUDED.dname WHERE (distributors.active AND distributors.did <> ?);" ||
+			batches[0].GetArguments()[2] != 1 || len(i.Conflict().Where().GetArguments()) != 0 {
+			t.Fatal("batch conflict where must not be shared")
+		}
+	})
+	t.Run("fit", func(t *testing.T) {
+		i := NewInsert().Into("distributors")
+		i.Columns().Add("did", "dname")
+		i.Columns().Arg(1, "a")
+		batches, err := i.Batches(0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(batches) != 1 || batches[0] != i {
+			t.Fatal("wrong fit batch")
+		}
+	})
+	t.Run("invalid", func(t *testing.T) {
+		i := NewInsert().Into("distributors")
+		i.Columns().Add("did", "dname")
+		i.Columns().Arg(1, "a", 2)
+		if _, err := i.Batches(2); err != ErrInsertArgumentsCount {
+			t.Fatal("must be ErrInsertArgumentsCount")
+		}
+		i.Columns().Arg("b")
+		if _, err := i.Batches(1); err != ErrInsertParamsLimit {
+			t.Fatal("must be ErrInsertParamsLimit")
+		}
+	})
+}
+
+func TestInsert_FromSelect(t *testing.T) {
+	t.Run("select", func(t *testing.T) {
+		sub := NewSelect().From("tmp_films")
+		sub.Columns().Add("code", "title")
+		sub.Where().AddExpression("date_prod < ?", "2004-05-07")
+		w := NewSelect().From("archive")
+		w.Columns().Add("code")
+		w.Where().AddExpression("kind = ?", "Comedy")
+
+		i := NewInsert().Into("films")
+		i.With().Add("arch", w)
+		i.Columns().Add("code", "title")
+		i.FromSelect(sub)
+		i.Conflict().Object("code").Action(ConflictActionUpdate).Set().Append("title = ?", "updated")
+		query, params, _ := i.SQL()
+		t.Log(query)
+		if query != "WITH arch AS (SELECT code FROM archive WHERE (kind = ?)) INSERT INTO films (code, title) SELECT code, title FROM tmp_films WHERE (date_prod < ?) ON CONFLICT (code) DO UPDATE SET title = ?;" {
+			t.Fatal("wrong insert from select")
+		}
+		if len(params) != 3 || params[0] != "Comedy" || params[1] != "2004-05-07" || params[2] != "updated" {
+			t.Fatal("wrong params order")
+		}
+	})
+	t.Run("values", func(t *testing.T) {
+		v := NewValues().Arg("UA502", "Bananas").Arg("T_601", "Yojimbo")
+		v.Row().Append("?, DEFAULT", "B6717")
+		i := NewInsert().Into("films").FromValues(v)
+		i.Columns().Add("code", "title")
+		query, params, _ := i.SQL()
+		t.Log(query)
+		if query != "INSERT INTO films (code, title) VALUES (?, ?), (?, ?), (?, DEFAULT);" || len(params) != 5 {
+			t.Fatal("wrong insert from values")
+		}
+		i.ResetFrom()
+		if i.String() != "INSERT INTO films (code, title);" {
+			t.Fatal("wrong reset from")
+		}
+	})
+}
+
+func TestInsert_RawValues(t *testing.T) {
+	t.Run("raw_and_default", func(t *testing.T) {
+		i := NewInsert().Into("films")
+		i.Columns().Add("code", "title", "did", "date_prod", "kind")
+		i.Columns().Arg("T_601", "Yojimbo", Raw("nextval(?)", "did_seq"), Raw("now()"), DefaultValue)
+		i.Columns().Arg("B6717", "Tampopo", 110, "1985-02-10", "Comedy")
+		query, params, _ := i.SQL()
+		t.Log(query)
+		if query != "INSERT INTO films (code, title, did, date_prod, kind) VALUES (?, ?, nextval(?), now(), DEFAULT), (?, ?, ?, ?, ?);" {
+			t.Fatal("wrong raw_and_default")
+		}
+		if len(params) != 8 || params[2] != "did_seq" || params[3] != "B6717" {
+			t.Fatal("wrong raw_and_default params")
+		}
+		if i.Validate() != nil {
+			t.Fatal("must be valid")
+		}
+		i.Columns().Arg("X", "Y", Raw("nextval(?)"), 1, 2)
+		if i.Validate() != ErrInsertPlaceholders {
+			t.Fatal("must be ErrInsertPlaceholders")
+		}
+	})
+	t.Run("raw_placeholders", func(t *testing.T) {
+		if !Raw("'?' || ?", "x").IsValid() ||
+			!Raw(`"why?" + ?`, 1).IsValid() ||
+			!Raw("? ?| array['a', 'b']", `{"a":1}`).IsValid() ||
+			!Raw("?::jsonb ? 'key'", `{"key":1}`).IsValid() ||
+			Raw("coalesce(?, ?)", 1).IsValid() {
+			t.Fatal("wrong raw placeholders count")
+		}
+	})
+	t.Run("default_values", func(t *testing.T) {
+		i := NewInsert().Into("films").DefaultValues()
+		i.Returning().Add("id")
+		t.Log(i.String())
+		if i.String() != "INSERT INTO films DEFAULT VALUES RETURNING id;" || len(i.GetArguments()) != 0 {
+			t.Fatal("wrong default_values")
+		}
+		i.Columns().Add("title")
+		if i.String() != "INSERT INTO films DEFAULT VALUES RETURNING id;" {
+			t.Fatal("wrong default_values with columns")
+		}
+	})
+	t.Run("overriding", func(t *testing.T) {
+		i := NewInsert().Into("films").Overriding(OverridingSystemValue)
+		i.Columns().Add("id", "title")
+		i.Columns().Arg(1, "Yojimbo")
+		t.Log(i.String())
+		if i.String() != "INSERT INTO films (id, title) OVERRIDING SYSTEM VALUE VALUES (?, ?);" {
+			t.Fatal("wrong overriding")
+		}
+	})
+	t.Run("batches", func(t *testing.T) {
+		i := NewInsert().Into("films")
+		i.Columns().Add("code", "date_prod")
+		i.Columns().Arg(1, Raw("now()"), 2, Raw("now()"), 3, 4)
+		batches, err := i.Batches(2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(batches) != 2 || batches[0].String() != "INSERT INTO films (code, date_prod) VALUES (?, now()), (?, now());" {
+			t.Fatal("wrong batches with raw values")
+		}
+	})
+}
+
+func TestInsert_Upsert(t *testing.T) {
+	t.Run("simple", func(t *testing.T) {
+		i := NewInsert().Into("products")
+		i.Columns().Add("sku", "name", "price")
+		i.Columns().Arg("A1", "Apple", 10)
+		i.Upsert("sku")
+		t.Log(i.String())
+		if i.String() != "INSERT INTO products (sku, name, price) VALUES (?, ?, ?) ON CONFLICT (sku) DO UPDATE SET name = EXCLUDED.name, price = EXCLUDED.price;" {
+			t.Fatal("wrong simple upsert")
+		}
+	})
+	t.Run("options", func(t *testing.T) {
+		i := NewInsert().Into("products AS p")
+		i.Columns().Add("sku", "name", "price", "created_at")
+		i.Columns().Arg("A1", "Apple", 10, Raw("now()"))
+		i.Upsert("sku").Exclude("created_at").OnlyChanged().Set().Append("updated_at = ?", "2024-01-01")
+		i.Conflict().Where().AddExpression("p.locked IS FALSE")
+		query, params, _ := i.SQL()
+		t.Log(query)
+		if query != "INSERT INTO products AS p (sku, name, price, created_at) VALUES (?, ?, ?, now()) ON CONFLICT (sku) DO UPDATE SET name = EXCLUDED.name, price = EXCLUDED.price, updated_at = ? WHERE ((p.locked IS FALSE) AND (p.name IS DISTINCT FROM EXCLUDED.name OR p.price IS DISTINCT FROM EXCLUDED.price));" {
+			t.Fatal("wrong upsert with options")
+		}
+		if len(params) != 4 || params[3] != "2024-01-01" {
+			t.Fatal("wrong upsert params")
+		}
+	})
+	t.Run("nothing_to_update", func(t *testing.T) {
+		i := NewInsert().Into("tags")
+		i.Columns().Add("name")
+		i.Columns().Arg("go")
+		i.Upsert("name")
+		t.Log(i.String())
+		if i.String() != "INSERT INTO tags (name) VALUES (?) ON CONFLICT (name) DO NOTHING;" {
+			t.Fatal("wrong nothing_to_update")
+		}
+	})
+	t.Run("without_conflict_target", func(t *testing.T) {
+		i := NewInsert().Into("products")
+		i.Columns().Add("sku", "name")
+		i.Columns().Arg("A1", "Apple")
+		i.Upsert().Set().Append("updated_at = ?", "2024-01-01")
+		query, params, _ := i.SQL()
+		t.Log(query)
+		if query != "INSERT INTO products (sku, name) VALUES (?, ?) ON CONFLICT DO NOTHING;" || len(params) != 2 {
+			t.Fatal("wrong without_conflict_target")
+		}
+		i.Conflict().Constraint("products_sku_key")
+		t.Log(i.String())
+		if i.String() != "INSERT INTO products (sku, name) VALUES (?, ?) ON CONFLICT ON CONSTRAINT products_sku_key DO UPDATE SET sku = EXCLUDED.sku, name = EXCLUDED.name, updated_at = ?;" {
+			t.Fatal("wrong upsert on constraint")
+		}
+	})
+}
+
+func TestInsert_Unnest(t *testing.T) {
+	t.Run("simple", func(t *testing.T) {
+		i := NewInsert().Into("films")
+		i.Columns().Add("did", "title", "len")
+		i.Columns().Arg(1, "Yojimbo", 110)
+		i.Columns().Arg(2, "Tampopo", nil)
+		i.Unnest(map[string]string{"did": "int", "title": "text", "len": "int"})
+		i.Conflict().Object("did").Action(ConflictActionNothing)
+		query, params, _ := i.SQL()
+		t.Log(query)
+		if query != "INSERT INTO films (did, title, len) SELECT * FROM unnest(?::int[], ?::text[], ?::int[]) ON CONFLICT (did) DO NOTHING;" {
+			t.Fatal("wrong unnest insert")
+		}
+		if len(params) != 3 {
+			t.Fatal("wrong unnest params count")
+		}
+		if ids, ok := params[0].([]int); !ok || len(ids) != 2 || ids[1] != 2 {
+			t.Fatal("wrong int array")
+		}
+		if titles, ok := params[1].([]string); !ok || titles[0] != "Yojimbo" {
+			t.Fatal("wrong string array")
+		}
+		if lens, ok := params[2].([]*int); !ok || *lens[0] != 110 || lens[1] != nil {
+			t.Fatal("wrong nullable array")
+		}
+	})
+	t.Run("wrap", func(t *testing.T) {
+		type wrapped struct{ array any }
+		i := NewInsert().Into("films")
+		i.Columns().Add("did", "kind")
+		i.Columns().Arg(1, "Comedy", int64(2), 1)
+		i.Unnest(map[string]string{"did": "bigint", "kind": "text"}).Wrap(func(array any) any { return wrapped{array} })
+		query, params, _ := i.SQL()
+		t.Log(query)
+		if query != "INSERT INTO films (did, kind) SELECT * FROM unnest(?::bigint[], ?::text[]);" {
+			t.Fatal("wrong unnest wrap insert")
+		}
+		if _, ok := params[0].(wrapped).array.([]any); !ok {
+			t.Fatal("wrong mixed array")
+		}
+	})
+	t.Run("raw_value", func(t *testing.T) {
+		i := NewInsert().Into("films")
+		i.Columns().Add("did")
+		i.Columns().Arg(DefaultValue)
+		i.Unnest(map[string]string{"did": "int"})
+		if i.Validate() != ErrUnnestRawValue {
+			t.Fatal("must be ErrUnnestRawValue")
+		}
+		query, params, _ := i.SQL()
+		t.Log(query)
+		if query != "INSERT INTO films (did) VALUES (DEFAULT);" || len(params) != 0 {
+			t.Fatal("raw value must be rendered as values list")
+		}
+	})
+	t.Run("missing_type", func(t *testing.T) {
+		i := NewInsert().Into("films")
+		i.Columns().Add("did", "kind")
+		i.Columns().Arg(1, "Comedy")
+		i.Unnest(map[string]string{"did": "int"})
+		if i.Validate() != ErrUnnestColumnType {
+			t.Fatal("must be ErrUnnestColumnType")
+		}
+		query, params, _ := i.SQL()
+		t.Log(query)
+		if query != "INSERT INTO films (did, kind) VALUES (?, ?);" || len(params) != 2 {
+			t.Fatal("missing type must be rendered as values list")
+		}
+	})
+}
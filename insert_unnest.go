@@ -0,0 +1,116 @@
+package gosql
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+)
+
+// ErrUnnestRawValue raw values can not be passed in unnest arrays
+var ErrUnnestRawValue = errors.New("gosql: raw values are not supported in unnest insert")
+
+// ErrUnnestColumnType type of unnest column array is not set
+var ErrUnnestColumnType = errors.New("gosql: unnest column type is not set")
+
+// unnest insert options
+// INSERT INTO table_name ( column_name [, ...] ) SELECT * FROM unnest( ?::type[] [, ...] )
+type unnest struct {
+	// column types
+	types map[string]string
+	// array argument wrapper
+	wrap func(array any) any
+}
+
+// Wrap set array argument wrapper. Example: pq.Array
+func (u *unnest) Wrap(wrap func(array any) any) *unnest {
+	u.wrap = wrap
+	return u
+}
+
+// Validate check every column has type and no raw values passed
+func (u *unnest) Validate(columns []string, args []any) error {
+	for _, column := range columns {
+		if u.types[strings.TrimSpace(column)] == "" {
+			return ErrUnnestColumnType
+		}
+	}
+	for _, arg := range args {
+		if _, ok := arg.(RawValue); ok {
+			return ErrUnnestRawValue
+		}
+	}
+	return nil
+}
+
+// String render unnest source
+func (u *unnest) String(columns []string) string {
+	b := strings.Builder{}
+	b.WriteString("SELECT * FROM unnest(")
+	for i, column := range columns {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString("?::" + u.types[strings.TrimSpace(column)] + "[]")
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+// GetArguments transpose row arguments into column arrays
+func (u *unnest) GetArguments(columns []string, args []any) []any {
+	arrays := make([]any, len(columns))
+	for i := range columns {
+		values := make([]any, 0, len(args)/len(columns)+1)
+		for j := i; j < len(args); j += len(columns) {
+			values = append(values, args[j])
+		}
+		arrays[i] = typedArray(values)
+		if u.wrap != nil {
+			arrays[i] = u.wrap(arrays[i])
+		}
+	}
+	return arrays
+}
+
+// typedArray convert values to slice of values type
+// Nullable values are converted to slice of pointers
+// Values of different types are returned as is
+func typedArray(values []any) any {
+	var t reflect.Type
+	var hasNil bool
+	for _, v := range values {
+		if v == nil {
+			hasNil = true
+		} else if t == nil {
+			t = reflect.TypeOf(v)
+		} else if t != reflect.TypeOf(v) {
+			return values
+		}
+	}
+	if t == nil {
+		return values
+	}
+	var pointer bool
+	if hasNil {
+		switch t.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		default:
+			t = reflect.PointerTo(t)
+			pointer = true
+		}
+	}
+	array := reflect.MakeSlice(reflect.SliceOf(t), len(values), len(values))
+	for i, v := range values {
+		if v == nil {
+			continue
+		}
+		rv := reflect.ValueOf(v)
+		if pointer {
+			p := reflect.New(rv.Type())
+			p.Elem().Set(rv)
+			rv = p
+		}
+		array.Index(i).Set(rv)
+	}
+	return array.Interface()
+}
@@ -0,0 +1,285 @@
+package gosql
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// TagName struct tag used for column mapping
+// Example: `db:"name,omitempty,readonly,pk"`
+const TagName = "db"
+
+const (
+	// TagOmitEmpty skip field on insert or update when value is zero
+	TagOmitEmpty = "omitempty"
+	// TagReadOnly field is never inserted or updated, but returned and selected
+	TagReadOnly = "readonly"
+	// TagPrimaryKey field is a part of primary key
+	TagPrimaryKey = "pk"
+)
+
+// MapOption option for struct mapping
+type MapOption uint8
+
+const (
+	// MapSkipZero skip all fields with zero values
+	MapSkipZero MapOption = 1 << iota
+	// MapSkipPrimaryKey do not set primary key fields, use them in where condition instead
+	MapSkipPrimaryKey
+)
+
+// ErrNotStruct value is not a struct or pointer to struct
+var ErrNotStruct = errors.New("gosql: value must be a struct or a pointer to struct")
+
+// ErrNotPointer value is not a pointer to struct
+var ErrNotPointer = errors.New("gosql: value must be a pointer to struct")
+
+// ErrNoColumns struct has no mapped columns
+var ErrNoColumns = errors.New("gosql: struct has no mapped columns")
+
+// ErrNoRows slice has no rows
+var ErrNoRows = errors.New("gosql: slice has no rows")
+
+// mapped struct field
+type mapField struct {
+	// column name
+	column string
+	// index path of field
+	index []int
+	// omitempty option
+	omitEmpty bool
+	// readonly option
+	readOnly bool
+	// primary key option
+	pk bool
+}
+
+// cache of mapped struct fields
+var mapCache sync.Map
+
+// getMapFields get mapped fields for struct type
+func getMapFields(t reflect.Type) []mapField {
+	if fields, ok := mapCache.Load(t); ok {
+		return fields.([]mapField)
+	}
+	fields := parseMapFields(t, nil)
+	mapCache.Store(t, fields)
+	return fields
+}
+
+// parseMapFields parse struct fields recursive
+func parseMapFields(t reflect.Type, parent []int) []mapField {
+	var fields []mapField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		index := append(append(make([]int, 0, len(parent)+1), parent...), i)
+		tag, ok := sf.Tag.Lookup(TagName)
+		if tag == "-" {
+			continue
+		}
+		if !ok {
+			if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+				fields = append(fields, parseMapFields(sf.Type, index)...)
+			}
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+		field := mapField{column: parts[0], index: index}
+		if field.column == "" {
+			continue
+		}
+		for _, option := range parts[1:] {
+			switch strings.TrimSpace(option) {
+			case TagOmitEmpty:
+				field.omitEmpty = true
+			case TagReadOnly:
+				field.readOnly = true
+			case TagPrimaryKey:
+				field.pk = true
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// structValue get struct value
+func structValue(v any) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return rv, ErrNotStruct
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return rv, ErrNotStruct
+	}
+	return rv, nil
+}
+
+// InsertFromStruct create insert query from struct
+// Readonly fields are added to returning when v is a pointer
+func InsertFromStruct(table string, v any) (*Insert, error) {
+	rv, err := structValue(v)
+	if err != nil {
+		return nil, err
+	}
+	i := NewInsert().Into(table)
+	var args []any
+	for _, field := range getMapFields(rv.Type()) {
+		value := rv.FieldByIndex(field.index)
+		if field.readOnly {
+			if rv.CanAddr() {
+				i.Returning().Append(field.column, value.Addr().Interface())
+			}
+			continue
+		}
+		if field.omitEmpty && value.IsZero() {
+			continue
+		}
+		i.Columns().Add(field.column)
+		args = append(args, value.Interface())
+	}
+	if len(args) == 0 {
+		return nil, ErrNoColumns
+	}
+	i.Columns().Arg(args...)
+	return i, nil
+}
+
+// InsertFromSlice create multi row insert query from slice of structs
+// Omitempty option is ignored to keep rows aligned
+func InsertFromSlice(table string, v any) (*Insert, error) {
+	rv, fields, err := sliceFields(v)
+	if err != nil {
+		return nil, err
+	}
+	if rv.Len() == 0 {
+		return nil, ErrNoRows
+	}
+	i := NewInsert().Into(table)
+	for _, field := range fields {
+		i.Columns().Add(field.column)
+	}
+	for j := 0; j < rv.Len(); j++ {
+		row, err := structValue(rv.Index(j).Interface())
+		if err != nil {
+			return nil, err
+		}
+		for _, field := range fields {
+			i.Columns().Arg(row.FieldByIndex(field.index).Interface())
+		}
+	}
+	return i, nil
+}
+
+// ValuesFromSlice create values list from slice of structs
+// Returns values and column names in order of values
+func ValuesFromSlice(v any) (*Values, []string, error) {
+	rv, fields, err := sliceFields(v)
+	if err != nil {
+		return nil, nil, err
+	}
+	columns := make([]string, 0, len(fields))
+	for _, field := range fields {
+		columns = append(columns, field.column)
+	}
+	values := NewValues()
+	for j := 0; j < rv.Len(); j++ {
+		row, err := structValue(rv.Index(j).Interface())
+		if err != nil {
+			return nil, nil, err
+		}
+		args := make([]any, 0, len(fields))
+		for _, field := range fields {
+			args = append(args, row.FieldByIndex(field.index).Interface())
+		}
+		values.Arg(args...)
+	}
+	return values, columns, nil
+}
+
+// sliceFields get slice value and writable fields of its struct elements
+func sliceFields(v any) (reflect.Value, []mapField, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return rv, nil, ErrNotStruct
+	}
+	t := rv.Type().Elem()
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return rv, nil, ErrNotStruct
+	}
+	fields := make([]mapField, 0, t.NumField())
+	for _, field := range getMapFields(t) {
+		if field.readOnly {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	if len(fields) == 0 {
+		return rv, nil, ErrNoColumns
+	}
+	return rv, fields, nil
+}
+
+// UpdateFromStruct create update query from struct
+// With MapSkipPrimaryKey option primary key fields are used in where condition
+func UpdateFromStruct(table string, v any, options ...MapOption) (*Update, error) {
+	rv, err := structValue(v)
+	if err != nil {
+		return nil, err
+	}
+	var option MapOption
+	for _, o := range options {
+		option |= o
+	}
+	u := NewUpdate().Table(table)
+	for _, field := range getMapFields(rv.Type()) {
+		value := rv.FieldByIndex(field.index)
+		if field.pk && option&MapSkipPrimaryKey != 0 {
+			u.Where().AddExpression(field.column+" = ?", value.Interface())
+			continue
+		}
+		if field.readOnly {
+			continue
+		}
+		if (field.omitEmpty || option&MapSkipZero != 0) && value.IsZero() {
+			continue
+		}
+		u.Set().Append(field.column+" = ?", value.Interface())
+	}
+	if u.Set().Len() == 0 {
+		return nil, ErrNoColumns
+	}
+	return u, nil
+}
+
+// SelectColumnsFor create select query with struct columns
+// Each column has scan destination pointed to struct field
+func SelectColumnsFor(v any) (*Select, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer {
+		return nil, ErrNotPointer
+	}
+	rv, err := structValue(v)
+	if err != nil {
+		return nil, err
+	}
+	q := NewSelect()
+	for _, field := range getMapFields(rv.Type()) {
+		q.Columns().Append(field.column, rv.FieldByIndex(field.index).Addr().Interface())
+	}
+	if q.Columns().Len() == 0 {
+		return nil, ErrNoColumns
+	}
+	return q, nil
+}
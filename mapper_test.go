@@ -0,0 +1,148 @@
+package gosql
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type mapperBase struct {
+	CreatedAt time.Time `db:"created_at,readonly"`
+}
+
+type mapperUser struct {
+	mapperBase
+	Id      int     `db:"id,readonly,pk"`
+	Name    string  `db:"name"`
+	Email   *string `db:"email,omitempty"`
+	Age     int     `db:"age"`
+	Skip    string  `db:"-"`
+	NoTag   string
+	private string `db:"private"`
+}
+
+func TestInsertFromStruct(t *testing.T) {
+	t.Run("pointer", func(t *testing.T) {
+		u := &mapperUser{Name: "foo", Age: 20}
+		i, err := InsertFromStruct("users", u)
+		if err != nil {
+			t.Fatal(err)
+		}
+		query, params, returning := i.SQL()
+		t.Log(query)
+		if query != "INSERT INTO users (name, age) VALUES (?, ?) RETURNING created_at, id;" {
+			t.Fatal("wrong insert from struct")
+		}
+		if len(params) != 2 || params[0] != "foo" || params[1] != 20 {
+			t.Fatal("wrong params")
+		}
+		if len(returning) != 2 || returning[0] != &u.CreatedAt || returning[1] != &u.Id {
+			t.Fatal("wrong returning")
+		}
+	})
+	t.Run("value", func(t *testing.T) {
+		email := "foo@bar.com"
+		i, err := InsertFromStruct("users", mapperUser{Name: "foo", Email: &email})
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Log(i.String())
+		if i.String() != "INSERT INTO users (name, email, age) VALUES (?, ?, ?);" {
+			t.Fatal("wrong insert from struct value")
+		}
+	})
+	t.Run("not_struct", func(t *testing.T) {
+		if _, err := InsertFromStruct("users", 10); err != ErrNotStruct {
+			t.Fatal("must be an error")
+		}
+	})
+}
+
+func TestInsertFromSlice(t *testing.T) {
+	users := []*mapperUser{{Name: "foo", Age: 1}, {Name: "bar", Age: 2}}
+	i, err := InsertFromSlice("users", users)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(i.String())
+	if i.String() != "INSERT INTO users (name, email, age) VALUES (?, ?, ?), (?, ?, ?);" {
+		t.Fatal("wrong insert from slice")
+	}
+	if len(i.GetArguments()) != 6 || i.GetArguments()[3] != "bar" {
+		t.Fatal("wrong params")
+	}
+	if _, err = InsertFromSlice("users", []mapperUser{}); err != ErrNoRows {
+		t.Fatal("must be ErrNoRows")
+	}
+}
+
+func TestValuesFromSlice(t *testing.T) {
+	users := []mapperUser{{Name: "foo", Age: 1}, {Name: "bar", Age: 2}}
+	v, columns, err := ValuesFromSlice(users)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(v.String())
+	if v.String() != "VALUES (?, ?, ?), (?, ?, ?)" || strings.Join(columns, ", ") != "name, email, age" {
+		t.Fatal("wrong values from slice")
+	}
+	if len(v.GetArguments()) != 6 || v.GetArguments()[5] != 2 {
+		t.Fatal("wrong params")
+	}
+	if _, _, err = ValuesFromSlice(users[0]); err != ErrNotStruct {
+		t.Fatal("must be ErrNotStruct")
+	}
+}
+
+func TestUpdateFromStruct(t *testing.T) {
+	t.Run("skip_pk", func(t *testing.T) {
+		u := mapperUser{Id: 5, Name: "foo"}
+		up, err := UpdateFromStruct("users", u, MapSkipPrimaryKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Log(up.String())
+		if up.String() != "UPDATE users SET name = ?, age = ? WHERE (id = ?);" {
+			t.Fatal("wrong update from struct")
+		}
+		if len(up.GetArguments()) != 3 || up.GetArguments()[2] != 5 {
+			t.Fatal("wrong params")
+		}
+	})
+	t.Run("skip_zero", func(t *testing.T) {
+		u := mapperUser{Id: 5, Name: "foo"}
+		up, err := UpdateFromStruct("users", &u, MapSkipZero, MapSkipPrimaryKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Log(up.String())
+		if up.String() != "UPDATE users SET name = ? WHERE (id = ?);" {
+			t.Fatal("wrong update from struct")
+		}
+	})
+	t.Run("no_columns", func(t *testing.T) {
+		if _, err := UpdateFromStruct("users", mapperUser{}, MapSkipZero); err != ErrNoColumns {
+			t.Fatal("must be an error")
+		}
+	})
+}
+
+func TestSelectColumnsFor(t *testing.T) {
+	u := mapperUser{}
+	q, err := SelectColumnsFor(&u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q.From("users")
+	query, _, returning := q.SQL()
+	t.Log(query)
+	if query != "SELECT created_at, id, name, email, age FROM users" {
+		t.Fatal("wrong select columns")
+	}
+	if len(returning) != 5 || returning[2] != &u.Name || returning[3] != &u.Email {
+		t.Fatal("wrong returning")
+	}
+	if _, err = SelectColumnsFor(u); err != ErrNotPointer {
+		t.Fatal("must be an error")
+	}
+}
@@ -2,10 +2,24 @@ package gosql
 
 import "strings"
 
+const (
+	// MergeMatched when target row matches source row
+	MergeMatched = "MATCHED"
+	// MergeNotMatched when source row does not match target row
+	MergeNotMatched = "NOT MATCHED"
+	// MergeNotMatchedBySource when target row does not match source row
+	MergeNotMatchedBySource = "NOT MATCHED BY SOURCE"
+	// MergeNotMatchedByTarget when source row does not match target row
+	MergeNotMatchedByTarget = "NOT MATCHED BY TARGET"
+	// MergeAction returning expression of executed merge action
+	MergeAction = "merge_action()"
+)
+
 // [ WITH with_query [, ...] ]
 // MERGE INTO [ ONLY ] target_table_name [ * ] [ [ AS ] target_alias ]
 // USING data_source ON join_condition
 // when_clause [...]
+// [ RETURNING { * | output_expression [ [ AS ] output_name ] } [, ...] ]
 //
 // where data_source is:
 //
@@ -15,7 +29,8 @@ import "strings"
 //
 // { WHEN MATCHED [ AND condition ] THEN { merge_update | merge_delete | DO NOTHING } |
 //
-//	WHEN NOT MATCHED [ AND condition ] THEN { merge_insert | DO NOTHING } }
+//	WHEN NOT MATCHED BY SOURCE [ AND condition ] THEN { merge_update | merge_delete | DO NOTHING } |
+//	WHEN NOT MATCHED [ BY TARGET ] [ AND condition ] THEN { merge_insert | DO NOTHING } }
 //
 // and merge_insert is:
 //
@@ -39,8 +54,22 @@ type Merge struct {
 	into detailedExpression
 	// Using
 	using string
+	// using arguments
+	usingArgs []any
+	// using sub query
+	usingQuery *Select
+	// using values list
+	usingValues *Values
+	// alias of using sub query or values
+	usingAlias string
+	// column names of using values
+	usingColumns []string
+	// join condition
+	on Condition
 	// When clause
 	when []when
+	// returning
+	returning expression
 }
 
 // With queries
@@ -60,9 +89,12 @@ func (m *Merge) IntoOnly(target string) *Merge {
 	return m.Into(target)
 }
 
-// Using datasource
-func (m *Merge) Using(datasource string) *Merge {
+// Using datasource with arguments
+func (m *Merge) Using(datasource string, args ...any) *Merge {
 	m.using = datasource
+	m.usingArgs = args
+	m.usingQuery = nil
+	m.usingValues = nil
 	return m
 }
 
@@ -71,12 +103,59 @@ func (m *Merge) UsingOnly(datasource string) *Merge {
 	return m.Using("ONLY " + datasource)
 }
 
+// UsingSelect sub query datasource. Query is rendered with merge
+func (m *Merge) UsingSelect(q *Select, alias string) *Merge {
+	m.Using("")
+	m.usingQuery = q
+	m.usingAlias = alias
+	return m
+}
+
+// UsingValues values list datasource with column names. Values are rendered with merge
+// Example: (VALUES (?, ?), (?, ?)) AS s(id, name)
+func (m *Merge) UsingValues(values *Values, alias string, columns ...string) *Merge {
+	m.Using("")
+	m.usingValues = values
+	m.usingAlias = alias
+	m.usingColumns = columns
+	return m
+}
+
+// datasource render using datasource and its arguments
+func (m *Merge) datasource() (string, []any) {
+	if m.usingQuery != nil {
+		query := m.usingQuery.String()
+		if !m.usingQuery.SubQuery {
+			query = "(" + query + ")"
+		}
+		return query + " AS " + m.usingAlias, m.usingQuery.GetArguments()
+	}
+	if m.usingValues != nil {
+		datasource := "(" + m.usingValues.String() + ") AS " + m.usingAlias
+		if len(m.usingColumns) > 0 {
+			datasource += "(" + strings.Join(m.usingColumns, ", ") + ")"
+		}
+		return datasource, m.usingValues.GetArguments()
+	}
+	return m.using, m.usingArgs
+}
+
+// On join condition of datasource
+func (m *Merge) On() *Condition {
+	return &m.on
+}
+
 // When clause
 func (m *Merge) When() *when {
 	m.when = append(m.when, when{})
 	return &m.when[len(m.when)-1]
 }
 
+// Returning get returning expression. Example: merge_action(), t.*
+func (m *Merge) Returning() *expression {
+	return &m.returning
+}
+
 // isWhenEmpty is when is empty
 func (m *Merge) isWhenEmpty() bool {
 	for _, w := range m.when {
@@ -111,7 +190,11 @@ func (m *Merge) IsEmpty() bool {
 		m.with.Len() == 0 &&
 			m.into.IsEmpty() &&
 			len(m.using) == 0 &&
-			m.isWhenEmpty()
+			m.usingQuery == nil &&
+			m.usingValues == nil &&
+			m.on.IsEmpty() &&
+			m.isWhenEmpty() &&
+			m.returning.Len() == 0
 }
 
 // String build query
@@ -128,31 +211,41 @@ func (m *Merge) String() string {
 		}
 		b.WriteString(m.into.String())
 	}
-	if len(m.using) > 0 {
-		b.WriteString("USING " + m.using)
+	if datasource, _ := m.datasource(); len(datasource) > 0 {
+		b.WriteString("USING " + datasource)
+	}
+	if !m.on.IsEmpty() {
+		b.WriteString(" ON " + m.on.String())
 	}
 	if !m.isWhenEmpty() {
 		b.WriteString(m.whenString())
 	}
+	if m.returning.Len() > 0 {
+		b.WriteString(" RETURNING " + m.returning.String(", "))
+	}
 	return b.String() + ";"
 }
 
 // GetArguments Get merge arguments
 func (m *Merge) GetArguments() []any {
-	return append(m.with.GetArguments(), m.whenArguments()...)
+	_, usingArgs := m.datasource()
+	return append(append(append(m.with.GetArguments(), usingArgs...), m.on.GetArguments()...), m.whenArguments()...)
 }
 
 // SQL Get sql query
 func (m *Merge) SQL() (query string, params []any, returning []any) {
-	return m.String(), m.GetArguments(), nil
+	return m.String(), m.GetArguments(), m.returning.GetArguments()
 }
 
 // NewMerge merge constructor
 func NewMerge() *Merge {
-	return &Merge{}
+	return &Merge{on: Condition{operator: ConditionOperatorAnd}}
 }
 
+// when clause of merge
 type when struct {
+	// MATCHED | NOT MATCHED | NOT MATCHED BY SOURCE | NOT MATCHED BY TARGET
+	match string
 	// condition
 	condition Condition
 	// update
@@ -165,6 +258,30 @@ type when struct {
 	doNoting bool
 }
 
+// Matched when target row matches source row
+func (w *when) Matched() *when {
+	w.match = MergeMatched
+	return w
+}
+
+// NotMatched when source row does not match target row
+func (w *when) NotMatched() *when {
+	w.match = MergeNotMatched
+	return w
+}
+
+// NotMatchedBySource when target row does not match source row
+func (w *when) NotMatchedBySource() *when {
+	w.match = MergeNotMatchedBySource
+	return w
+}
+
+// NotMatchedByTarget when source row does not match target row
+func (w *when) NotMatchedByTarget() *when {
+	w.match = MergeNotMatchedByTarget
+	return w
+}
+
 // Condition set condition
 func (w *when) Condition() *Condition {
 	return &w.condition
@@ -192,38 +309,35 @@ func (w *when) DoNothing() *when {
 	return w
 }
 
+// getMatch get match type. Insert is NOT MATCHED, others are MATCHED by default
+func (w *when) getMatch() string {
+	if w.match != "" {
+		return w.match
+	}
+	if !w.insert.IsEmpty() {
+		return MergeNotMatched
+	}
+	return MergeMatched
+}
+
 // String build query
 func (w *when) String() string {
 	if w.IsEmpty() {
 		return ""
 	}
 	b := strings.Builder{}
-	if w.update.Len() > 0 {
-		b.WriteString(" WHEN MATCHED ")
-		if !w.condition.IsEmpty() {
-			b.WriteString("AND " + w.Condition().String() + " ")
-		}
-		if w.doNoting {
-			b.WriteString("THEN DO NOTHING")
-		} else {
-			b.WriteString("THEN UPDATE SET " + w.update.String(", "))
-		}
+	b.WriteString(" WHEN " + w.getMatch() + " ")
+	if !w.condition.IsEmpty() {
+		b.WriteString("AND " + w.condition.String() + " ")
+	}
+	if w.doNoting {
+		b.WriteString("THEN DO NOTHING")
+	} else if w.update.Len() > 0 {
+		b.WriteString("THEN UPDATE SET " + w.update.String(", "))
 	} else if w.delete.Len() > 0 {
-		b.WriteString(" WHEN MATCHED ")
-		if !w.condition.IsEmpty() {
-			b.WriteString("AND " + w.Condition().String())
-		}
 		b.WriteString("THEN " + w.delete.String(","))
 	} else if !w.insert.IsEmpty() {
-		b.WriteString(" WHEN NOT MATCHED ")
-		if !w.condition.IsEmpty() {
-			b.WriteString("AND " + w.Condition().String() + " ")
-		}
-		if w.doNoting {
-			b.WriteString("THEN DO NOTHING")
-		} else {
-			b.WriteString("THEN " + w.insert.String())
-		}
+		b.WriteString("THEN " + w.insert.String())
 	}
 	return b.String()
 }
@@ -231,7 +345,7 @@ func (w *when) String() string {
 // IsEmpty check if empty
 func (w *when) IsEmpty() bool {
 	return w == nil ||
-		w.condition.IsEmpty() &&
+		!w.doNoting &&
 			w.insert.IsEmpty() &&
 			w.update.Len() == 0 &&
 			w.delete.Len() == 0
@@ -239,11 +353,14 @@ func (w *when) IsEmpty() bool {
 
 // GetArguments get all arguments
 func (w *when) GetArguments() []any {
-	if w.update.ArgLen() > 0 {
+	if w.doNoting {
+		return w.condition.GetArguments()
+	} else if w.update.Len() > 0 {
 		return append(w.condition.GetArguments(), w.update.GetArguments()...)
-	} else {
-		return append(w.condition.GetArguments(), w.insert.GetArguments()...)
+	} else if w.delete.Len() > 0 {
+		return w.condition.GetArguments()
 	}
+	return append(w.condition.GetArguments(), w.insert.GetArguments()...)
 }
 
 type mergeInsert struct {
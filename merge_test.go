@@ -110,6 +110,117 @@ func TestMerge_SQL(t *testing.T) {
 			t.Fatal("merge_4")
 		}
 	})
+
+	t.Run("merge_by_source", func(t *testing.T) {
+		// MERGE INTO wines w
+		// USING wine_stock_changes s
+		//  ON s.winename = w.winename
+		//  WHEN NOT MATCHED BY TARGET AND s.stock_delta > 0 THEN
+		//  INSERT VALUES(s.winename, s.stock_delta)
+		//  WHEN MATCHED AND w.stock + s.stock_delta > 0 THEN
+		//  UPDATE SET stock = w.stock + s.stock_delta
+		//  WHEN MATCHED THEN
+		//  DELETE
+		//  WHEN NOT MATCHED BY SOURCE THEN
+		//  UPDATE SET stock = ?
+		//  RETURNING merge_action(), w.*;
+
+		m := NewMerge().
+			Into("wines w").
+			Using("wine_stock_changes s ON s.winename = w.winename")
+
+		insertWhen := m.When().NotMatchedByTarget()
+		insertWhen.Condition().AddExpression("s.stock_delta > ?", 0)
+		insertWhen.Insert().Values().Add("s.winename", "s.stock_delta")
+
+		updateWhen := m.When()
+		updateWhen.Condition().AddExpression("w.stock + s.stock_delta > ?", 1)
+		updateWhen.Update().Add("stock = w.stock + s.stock_delta")
+
+		m.When().Delete()
+		m.When().NotMatchedBySource().Update().Append("stock = ?", 2)
+		m.Returning().Add(MergeAction, "w.*")
+
+		query, params, _ := m.SQL()
+		t.Log(query)
+		if query != "MERGE INTO wines w USING wine_stock_changes s ON s.winename = w.winename WHEN NOT MATCHED BY TARGET AND (s.stock_delta > ?) THEN INSERT VALUES (s.winename, s.stock_delta) WHEN MATCHED AND (w.stock + s.stock_delta > ?) THEN UPDATE SET stock = w.stock + s.stock_delta WHEN MATCHED THEN DELETE WHEN NOT MATCHED BY SOURCE THEN UPDATE SET stock = ? RETURNING merge_action(), w.*;" {
+			t.Fatal("wrong merge_by_source")
+		}
+		if len(params) != 3 || params[0] != 0 || params[1] != 1 || params[2] != 2 {
+			t.Fatal("wrong merge_by_source params")
+		}
+	})
+
+	t.Run("merge_do_nothing", func(t *testing.T) {
+		m := NewMerge().
+			Into("wines w").
+			Using("wine_stock_changes s ON s.winename = w.winename")
+
+		deleteWhen := m.When().Delete().DoNothing()
+		deleteWhen.Condition().AddExpression("w.stock > ?", 10)
+		m.When().NotMatched().DoNothing()
+		m.When().NotMatchedBySource().Delete()
+
+		query, params, _ := m.SQL()
+		t.Log(query)
+		if query != "MERGE INTO wines w USING wine_stock_changes s ON s.winename = w.winename WHEN MATCHED AND (w.stock > ?) THEN DO NOTHING WHEN NOT MATCHED THEN DO NOTHING WHEN NOT MATCHED BY SOURCE THEN DELETE;" || len(params) != 1 {
+			t.Fatal("wrong merge_do_nothing")
+		}
+	})
+
+	t.Run("merge_using_select", func(t *testing.T) {
+		sub := NewSelect().From("recent_transactions")
+		sub.Columns().Add("customer_id", "transaction_value")
+
+		tenants := NewSelect().From("tenant")
+		tenants.Columns().Add("id")
+		tenants.Where().AddExpression("active = ?", true)
+
+		m := NewMerge().
+			Into("customer_account ca").
+			UsingSelect(sub, "t")
+		// sub query is rendered with merge, so later changes are applied
+		sub.Where().AddExpression("tenant_id = ?", 7)
+		m.With().Add("tenants", tenants)
+		m.On().AddExpression("t.customer_id = ca.customer_id")
+		m.When().Update().Append("balance = balance + t.transaction_value * ?", 2)
+
+		query, params, _ := m.SQL()
+		t.Log(query)
+		if query != "WITH tenants AS (SELECT id FROM tenant WHERE (active = ?)) MERGE INTO customer_account ca USING (SELECT customer_id, transaction_value FROM recent_transactions WHERE (tenant_id = ?)) AS t ON (t.customer_id = ca.customer_id) WHEN MATCHED THEN UPDATE SET balance = balance + t.transaction_value * ?;" {
+			t.Fatal("wrong merge_using_select")
+		}
+		if len(params) != 3 || params[0] != true || params[1] != 7 || params[2] != 2 {
+			t.Fatal("wrong merge_using_select params")
+		}
+	})
+
+	t.Run("merge_using_values", func(t *testing.T) {
+		type wine struct {
+			Name  string `db:"winename"`
+			Stock int    `db:"stock"`
+		}
+		values, columns, err := ValuesFromSlice([]wine{{Name: "Chateau", Stock: 10}, {Name: "Rioja", Stock: 5}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		m := NewMerge().
+			Into("wines w").
+			UsingValues(values, "s", columns...)
+		m.On().AddExpression("s.winename = w.winename")
+		m.When().Update().Add("stock = s.stock")
+		m.When().Insert().Columns(columns...).Values().Add("s.winename", "s.stock")
+		m.When().NotMatchedBySource().Delete()
+
+		query, params, _ := m.SQL()
+		t.Log(query)
+		if query != "MERGE INTO wines w USING (VALUES (?, ?), (?, ?)) AS s(winename, stock) ON (s.winename = w.winename) WHEN MATCHED THEN UPDATE SET stock = s.stock WHEN NOT MATCHED THEN INSERT (winename, stock) VALUES (s.winename, s.stock) WHEN NOT MATCHED BY SOURCE THEN DELETE;" {
+			t.Fatal("wrong merge_using_values")
+		}
+		if len(params) != 4 || params[0] != "Chateau" || params[3] != 5 {
+			t.Fatal("wrong merge_using_values params")
+		}
+	})
 }
 
 // goos: darwin
@@ -0,0 +1,179 @@
+package gosql
+
+import "strings"
+
+const (
+	// PolicyAll policy applies to all commands
+	PolicyAll = "ALL"
+	// PolicySelect policy applies to select
+	PolicySelect = "SELECT"
+	// PolicyInsert policy applies to insert
+	PolicyInsert = "INSERT"
+	// PolicyUpdate policy applies to update
+	PolicyUpdate = "UPDATE"
+	// PolicyDelete policy applies to delete
+	PolicyDelete = "DELETE"
+)
+
+// Policy create or alter row level security policy query builder
+// Arguments of using and with check conditions are inlined as literals
+// CREATE POLICY name ON table_name
+//
+//	[ AS { PERMISSIVE | RESTRICTIVE } ]
+//	[ FOR { ALL | SELECT | INSERT | UPDATE | DELETE } ]
+//	[ TO { role_name | PUBLIC | CURRENT_ROLE | CURRENT_USER | SESSION_USER } [, ...] ]
+//	[ USING ( using_expression ) ]
+//	[ WITH CHECK ( check_expression ) ]
+//
+// ALTER POLICY name ON table_name RENAME TO new_name
+//
+// ALTER POLICY name ON table_name
+//
+//	[ TO { role_name | PUBLIC | CURRENT_ROLE | CURRENT_USER | SESSION_USER } [, ...] ]
+//	[ USING ( using_expression ) ]
+//	[ WITH CHECK ( check_expression ) ]
+type Policy struct {
+	// is alter query
+	alter bool
+	// policy name
+	name string
+	// table name
+	table string
+	// PERMISSIVE | RESTRICTIVE
+	as string
+	// command
+	command string
+	// roles
+	roles expression
+	// using condition
+	using Condition
+	// with check condition
+	check Condition
+	// new name
+	rename string
+}
+
+// Permissive policy combined with other permissive policies using OR
+func (p *Policy) Permissive() *Policy {
+	p.as = "PERMISSIVE"
+	return p
+}
+
+// Restrictive policy combined with other policies using AND
+func (p *Policy) Restrictive() *Policy {
+	p.as = "RESTRICTIVE"
+	return p
+}
+
+// For set command policy applies to. Example: PolicySelect
+func (p *Policy) For(command string) *Policy {
+	p.command = command
+	return p
+}
+
+// To add roles policy applies to
+func (p *Policy) To(role ...string) *Policy {
+	p.roles.Add(role...)
+	return p
+}
+
+// Using condition of visible existing rows
+func (p *Policy) Using() *Condition {
+	return &p.using
+}
+
+// WithCheck condition of inserted or updated rows
+func (p *Policy) WithCheck() *Condition {
+	return &p.check
+}
+
+// Rename policy
+func (p *Policy) Rename(name string) *Policy {
+	p.rename = name
+	return p
+}
+
+// Validate check condition arguments can be inlined. Conditions are not rendered on rename
+func (p *Policy) Validate() error {
+	if p.alter && p.rename != "" {
+		return nil
+	}
+	if _, err := inlineArguments(p.using.String(), p.using.GetArguments()); err != nil {
+		return err
+	}
+	_, err := inlineArguments(p.check.String(), p.check.GetArguments())
+	return err
+}
+
+// IsEmpty check if empty. Alter policy is empty without rename, roles or conditions
+func (p *Policy) IsEmpty() bool {
+	if p == nil || p.name == "" || p.table == "" {
+		return true
+	}
+	return p.alter && p.rename == "" && p.roles.Len() == 0 && p.using.IsEmpty() && p.check.IsEmpty()
+}
+
+// String render policy query. Panics if condition arguments can not be inlined, use Validate to check
+func (p *Policy) String() string {
+	if p.IsEmpty() {
+		return ""
+	}
+	if p.alter && p.rename != "" {
+		return "ALTER POLICY " + p.name + " ON " + p.table + " RENAME TO " + p.rename + ";"
+	}
+	var using, check string
+	if !p.using.IsEmpty() {
+		using = mustInlineArguments(p.using.String(), p.using.GetArguments())
+	}
+	if !p.check.IsEmpty() {
+		check = mustInlineArguments(p.check.String(), p.check.GetArguments())
+	}
+	b := strings.Builder{}
+	if p.alter {
+		b.WriteString("ALTER")
+	} else {
+		b.WriteString("CREATE")
+	}
+	b.WriteString(" POLICY " + p.name + " ON " + p.table)
+	if !p.alter {
+		if p.as != "" {
+			b.WriteString(" AS " + p.as)
+		}
+		if p.command != "" {
+			b.WriteString(" FOR " + p.command)
+		}
+	}
+	if p.roles.Len() > 0 {
+		b.WriteString(" TO " + p.roles.String(", "))
+	}
+	if using != "" {
+		b.WriteString(" USING " + using)
+	}
+	if check != "" {
+		b.WriteString(" WITH CHECK " + check)
+	}
+	return b.String() + ";"
+}
+
+// SQL common sql interface
+func (p *Policy) SQL() (query string, params []any, returning []any) {
+	query = p.String()
+	return
+}
+
+// CreatePolicy create policy constructor
+func CreatePolicy(name string, table string) *Policy {
+	return &Policy{
+		name:  name,
+		table: table,
+		using: Condition{operator: ConditionOperatorAnd},
+		check: Condition{operator: ConditionOperatorAnd},
+	}
+}
+
+// AlterPolicy alter policy constructor
+func AlterPolicy(name string, table string) *Policy {
+	p := CreatePolicy(name, table)
+	p.alter = true
+	return p
+}
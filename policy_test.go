@@ -0,0 +1,76 @@
+package gosql
+
+import "testing"
+
+func TestPolicy_String(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		p := CreatePolicy("account_managers", "accounts").Restrictive().For(PolicyUpdate).To("managers", "CURRENT_USER")
+		p.Using().AddExpression("manager = current_user")
+		p.WithCheck().AddExpression("status <> ?", "closed")
+		t.Log(p.String())
+		if p.String() != "CREATE POLICY account_managers ON accounts AS RESTRICTIVE FOR UPDATE TO managers, CURRENT_USER USING (manager = current_user) WITH CHECK (status <> 'closed');" {
+			t.Fatal("wrong create")
+		}
+	})
+	t.Run("create_simple", func(t *testing.T) {
+		p := CreatePolicy("user_policy", "users")
+		p.Using().AddExpression("user_name = current_user")
+		t.Log(p.String())
+		if p.String() != "CREATE POLICY user_policy ON users USING (user_name = current_user);" {
+			t.Fatal("wrong create_simple")
+		}
+	})
+	t.Run("alter", func(t *testing.T) {
+		p := AlterPolicy("user_policy", "users").To("PUBLIC")
+		p.WithCheck().AddExpression("tenant_id = ?", 5)
+		t.Log(p.String())
+		if p.String() != "ALTER POLICY user_policy ON users TO PUBLIC WITH CHECK (tenant_id = 5);" {
+			t.Fatal("wrong alter")
+		}
+		if AlterPolicy("user_policy", "users").Rename("users_own").String() != "ALTER POLICY user_policy ON users RENAME TO users_own;" {
+			t.Fatal("wrong rename")
+		}
+	})
+	t.Run("alter_rename_ignores_conditions", func(t *testing.T) {
+		p := AlterPolicy("user_policy", "users").Rename("users_own")
+		p.Using().AddExpression("id = ?", struct{}{})
+		t.Log(p.String())
+		if p.Validate() != nil || p.String() != "ALTER POLICY user_policy ON users RENAME TO users_own;" {
+			t.Fatal("wrong alter_rename_ignores_conditions")
+		}
+	})
+	t.Run("alter_empty", func(t *testing.T) {
+		p := AlterPolicy("user_policy", "users")
+		if !p.IsEmpty() || p.String() != "" {
+			t.Fatal("alter without action must be empty")
+		}
+	})
+	t.Run("jsonb_operator", func(t *testing.T) {
+		p := CreatePolicy("keyed", "docs")
+		p.Using().AddExpression("data ? 'owner'")
+		t.Log(p.String())
+		if p.Validate() != nil || p.String() != "CREATE POLICY keyed ON docs USING (data ? 'owner');" {
+			t.Fatal("wrong jsonb_operator")
+		}
+	})
+	t.Run("invalid", func(t *testing.T) {
+		p := CreatePolicy("user_policy", "users")
+		p.Using().AddExpression("id = ?", struct{}{})
+		if p.Validate() != ErrInlineArgument {
+			t.Fatal("must be invalid")
+		}
+		defer func() {
+			if recover() != ErrInlineArgument {
+				t.Fatal("must panic with ErrInlineArgument")
+			}
+		}()
+		p.SQL()
+	})
+	t.Run("drop", func(t *testing.T) {
+		d := DropPolicy("user_policy", "users").IfExists()
+		t.Log(d.String())
+		if d.String() != "DROP POLICY IF EXISTS user_policy ON users;" {
+			t.Fatal("wrong drop")
+		}
+	})
+}
@@ -0,0 +1,296 @@
+package gosql
+
+import "strings"
+
+const (
+	// PrivilegeSelect select privilege
+	PrivilegeSelect = "SELECT"
+	// PrivilegeInsert insert privilege
+	PrivilegeInsert = "INSERT"
+	// PrivilegeUpdate update privilege
+	PrivilegeUpdate = "UPDATE"
+	// PrivilegeDelete delete privilege
+	PrivilegeDelete = "DELETE"
+	// PrivilegeTruncate truncate privilege
+	PrivilegeTruncate = "TRUNCATE"
+	// PrivilegeReferences references privilege
+	PrivilegeReferences = "REFERENCES"
+	// PrivilegeTrigger trigger privilege
+	PrivilegeTrigger = "TRIGGER"
+	// PrivilegeCreate create privilege
+	PrivilegeCreate = "CREATE"
+	// PrivilegeConnect connect privilege
+	PrivilegeConnect = "CONNECT"
+	// PrivilegeTemporary temporary privilege
+	PrivilegeTemporary = "TEMPORARY"
+	// PrivilegeExecute execute privilege
+	PrivilegeExecute = "EXECUTE"
+	// PrivilegeUsage usage privilege
+	PrivilegeUsage = "USAGE"
+	// PrivilegeAll all privileges
+	PrivilegeAll = "ALL PRIVILEGES"
+)
+
+const (
+	// PrivilegeOnTables default privileges of tables
+	PrivilegeOnTables = "TABLES"
+	// PrivilegeOnSequences default privileges of sequences
+	PrivilegeOnSequences = "SEQUENCES"
+	// PrivilegeOnFunctions default privileges of functions
+	PrivilegeOnFunctions = "FUNCTIONS"
+	// PrivilegeOnRoutines default privileges of routines
+	PrivilegeOnRoutines = "ROUTINES"
+	// PrivilegeOnTypes default privileges of types
+	PrivilegeOnTypes = "TYPES"
+	// PrivilegeOnSchemas default privileges of schemas
+	PrivilegeOnSchemas = "SCHEMAS"
+)
+
+// Privilege grant or revoke query builder
+// GRANT { privilege [ ( column_name [, ...] ) ] [, ...] | ALL [ PRIVILEGES ] }
+//
+//	ON { [ TABLE ] table_name [, ...] | ALL TABLES IN SCHEMA schema_name [, ...]
+//	   | SEQUENCE sequence_name [, ...] | ALL SEQUENCES IN SCHEMA schema_name [, ...]
+//	   | SCHEMA schema_name [, ...]
+//	   | FUNCTION function_name [ ( [ [ argmode ] [ arg_name ] arg_type [, ...] ] ) ] [, ...]
+//	   | ALL FUNCTIONS IN SCHEMA schema_name [, ...] }
+//	TO role_specification [, ...] [ WITH GRANT OPTION ]
+//
+// REVOKE [ GRANT OPTION FOR ] { privilege [ ( column_name [, ...] ) ] [, ...] | ALL [ PRIVILEGES ] }
+//
+//	ON { ... }
+//	FROM role_specification [, ...]
+//	[ CASCADE | RESTRICT ]
+type Privilege struct {
+	// is revoke query
+	revoke bool
+	// privileges
+	privileges expression
+	// object type
+	object string
+	// object names
+	names expression
+	// roles
+	roles expression
+	// with grant option
+	grantOption bool
+	// CASCADE | RESTRICT
+	option string
+}
+
+// Privilege add privileges. Example: PrivilegeSelect
+func (p *Privilege) Privilege(privilege ...string) *Privilege {
+	p.privileges.Add(privilege...)
+	return p
+}
+
+// Column add column privilege. Ignored without columns. Example: UPDATE (name, price)
+func (p *Privilege) Column(privilege string, column ...string) *Privilege {
+	if len(column) > 0 {
+		p.privileges.Add(privilege + " (" + strings.Join(column, ", ") + ")")
+	}
+	return p
+}
+
+// On set object type and names. Object type is optional. Example: On("TABLE", "films")
+func (p *Privilege) On(object string, name ...string) *Privilege {
+	p.object = object
+	p.names.Reset()
+	p.names.Add(name...)
+	return p
+}
+
+// OnTable set tables
+func (p *Privilege) OnTable(name ...string) *Privilege {
+	return p.On("TABLE", name...)
+}
+
+// OnAllTablesInSchema set all tables in schemas
+func (p *Privilege) OnAllTablesInSchema(schema ...string) *Privilege {
+	return p.On("ALL TABLES IN SCHEMA", schema...)
+}
+
+// OnSequence set sequences
+func (p *Privilege) OnSequence(name ...string) *Privilege {
+	return p.On("SEQUENCE", name...)
+}
+
+// OnAllSequencesInSchema set all sequences in schemas
+func (p *Privilege) OnAllSequencesInSchema(schema ...string) *Privilege {
+	return p.On("ALL SEQUENCES IN SCHEMA", schema...)
+}
+
+// OnSchema set schemas
+func (p *Privilege) OnSchema(name ...string) *Privilege {
+	return p.On("SCHEMA", name...)
+}
+
+// OnFunction set functions. Name may contain argument types. Example: sqrt(integer)
+func (p *Privilege) OnFunction(name ...string) *Privilege {
+	return p.On("FUNCTION", name...)
+}
+
+// OnAllFunctionsInSchema set all functions in schemas
+func (p *Privilege) OnAllFunctionsInSchema(schema ...string) *Privilege {
+	return p.On("ALL FUNCTIONS IN SCHEMA", schema...)
+}
+
+// To add grantee roles
+func (p *Privilege) To(role ...string) *Privilege {
+	p.roles.Add(role...)
+	return p
+}
+
+// From add roles to revoke from
+func (p *Privilege) From(role ...string) *Privilege {
+	return p.To(role...)
+}
+
+// GrantOption grant with grant option or revoke only grant option
+func (p *Privilege) GrantOption() *Privilege {
+	p.grantOption = true
+	return p
+}
+
+// Cascade revoke dependent privileges
+func (p *Privilege) Cascade() *Privilege {
+	p.option = ActionCascade
+	return p
+}
+
+// Restrict refuse to revoke if dependent privileges exist
+func (p *Privilege) Restrict() *Privilege {
+	p.option = ActionRestrict
+	return p
+}
+
+// IsEmpty check if empty. Object names are required
+func (p *Privilege) IsEmpty() bool {
+	return p == nil || p.privileges.Len() == 0 || p.roles.Len() == 0 || p.names.Len() == 0
+}
+
+// statement render grant or revoke without semicolon
+func (p *Privilege) statement() string {
+	b := strings.Builder{}
+	if p.revoke {
+		b.WriteString("REVOKE ")
+		if p.grantOption {
+			b.WriteString("GRANT OPTION FOR ")
+		}
+	} else {
+		b.WriteString("GRANT ")
+	}
+	b.WriteString(p.privileges.String(", ") + " ON")
+	if p.object != "" {
+		b.WriteString(" " + p.object)
+	}
+	if p.names.Len() > 0 {
+		b.WriteString(" " + p.names.String(", "))
+	}
+	if p.revoke {
+		b.WriteString(" FROM " + p.roles.String(", "))
+		if p.option != "" {
+			b.WriteString(" " + p.option)
+		}
+	} else {
+		b.WriteString(" TO " + p.roles.String(", "))
+		if p.grantOption {
+			b.WriteString(" WITH GRANT OPTION")
+		}
+	}
+	return b.String()
+}
+
+// String render grant or revoke query
+func (p *Privilege) String() string {
+	if p.IsEmpty() {
+		return ""
+	}
+	return p.statement() + ";"
+}
+
+// SQL common sql interface
+func (p *Privilege) SQL() (query string, params []any, returning []any) {
+	query = p.String()
+	return
+}
+
+// Grant grant privileges constructor
+func Grant(privilege ...string) *Privilege {
+	return (&Privilege{}).Privilege(privilege...)
+}
+
+// Revoke revoke privileges constructor
+func Revoke(privilege ...string) *Privilege {
+	return (&Privilege{revoke: true}).Privilege(privilege...)
+}
+
+// DefaultPrivileges alter default privileges query builder
+// ALTER DEFAULT PRIVILEGES
+//
+//	[ FOR { ROLE | USER } target_role [, ...] ]
+//	[ IN SCHEMA schema_name [, ...] ]
+//	abbreviated_grant_or_revoke
+//
+// where abbreviated_grant_or_revoke is grant or revoke on one of:
+//
+// TABLES | SEQUENCES | FUNCTIONS | ROUTINES | TYPES | SCHEMAS
+type DefaultPrivileges struct {
+	// target roles
+	roles expression
+	// schemas
+	schemas expression
+	// grant or revoke
+	privilege *Privilege
+}
+
+// ForRole add target roles of created objects
+func (d *DefaultPrivileges) ForRole(role ...string) *DefaultPrivileges {
+	d.roles.Add(role...)
+	return d
+}
+
+// InSchema add schemas of created objects
+func (d *DefaultPrivileges) InSchema(schema ...string) *DefaultPrivileges {
+	d.schemas.Add(schema...)
+	return d
+}
+
+// IsEmpty check if empty. Privilege object must be one of PrivilegeOn constants without names
+func (d *DefaultPrivileges) IsEmpty() bool {
+	if d == nil || d.privilege == nil || d.privilege.privileges.Len() == 0 || d.privilege.roles.Len() == 0 || d.privilege.names.Len() > 0 {
+		return true
+	}
+	switch d.privilege.object {
+	case PrivilegeOnTables, PrivilegeOnSequences, PrivilegeOnFunctions, PrivilegeOnRoutines, PrivilegeOnTypes, PrivilegeOnSchemas:
+		return false
+	}
+	return true
+}
+
+// String render alter default privileges query
+func (d *DefaultPrivileges) String() string {
+	if d.IsEmpty() {
+		return ""
+	}
+	b := strings.Builder{}
+	b.WriteString("ALTER DEFAULT PRIVILEGES")
+	if d.roles.Len() > 0 {
+		b.WriteString(" FOR ROLE " + d.roles.String(", "))
+	}
+	if d.schemas.Len() > 0 {
+		b.WriteString(" IN SCHEMA " + d.schemas.String(", "))
+	}
+	return b.String() + " " + d.privilege.statement() + ";"
+}
+
+// SQL common sql interface
+func (d *DefaultPrivileges) SQL() (query string, params []any, returning []any) {
+	query = d.String()
+	return
+}
+
+// AlterDefaultPrivileges alter default privileges constructor. Privilege object must be one of PrivilegeOn constants
+func AlterDefaultPrivileges(privilege *Privilege) *DefaultPrivileges {
+	return &DefaultPrivileges{privilege: privilege}
+}
@@ -0,0 +1,99 @@
+package gosql
+
+import "testing"
+
+func TestPrivilege_String(t *testing.T) {
+	t.Run("grant_table", func(t *testing.T) {
+		g := Grant(PrivilegeSelect, PrivilegeInsert).OnTable("films", "kinds").To("manuel").GrantOption()
+		t.Log(g.String())
+		if g.String() != "GRANT SELECT, INSERT ON TABLE films, kinds TO manuel WITH GRANT OPTION;" {
+			t.Fatal("wrong grant_table")
+		}
+	})
+	t.Run("grant_column", func(t *testing.T) {
+		g := Grant(PrivilegeSelect).Column(PrivilegeUpdate, "title", "len").OnTable("films").To("PUBLIC")
+		t.Log(g.String())
+		if g.String() != "GRANT SELECT, UPDATE (title, len) ON TABLE films TO PUBLIC;" {
+			t.Fatal("wrong grant_column")
+		}
+	})
+	t.Run("grant_other", func(t *testing.T) {
+		if Grant(PrivilegeUsage).OnSchema("app").To("reader").String() != "GRANT USAGE ON SCHEMA app TO reader;" ||
+			Grant(PrivilegeUsage, PrivilegeSelect).OnAllSequencesInSchema("app").To("writer").String() != "GRANT USAGE, SELECT ON ALL SEQUENCES IN SCHEMA app TO writer;" ||
+			Grant(PrivilegeExecute).OnFunction("add(integer, integer)").To("writer").String() != "GRANT EXECUTE ON FUNCTION add(integer, integer) TO writer;" ||
+			Grant(PrivilegeAll).OnAllTablesInSchema("app").To("admin").String() != "GRANT ALL PRIVILEGES ON ALL TABLES IN SCHEMA app TO admin;" {
+			t.Fatal("wrong grant_other")
+		}
+	})
+	t.Run("revoke", func(t *testing.T) {
+		r := Revoke(PrivilegeInsert).OnTable("films").From("PUBLIC")
+		t.Log(r.String())
+		if r.String() != "REVOKE INSERT ON TABLE films FROM PUBLIC;" {
+			t.Fatal("wrong revoke")
+		}
+		r = Revoke(PrivilegeSelect).OnAllTablesInSchema("app").From("manuel").GrantOption().Cascade()
+		t.Log(r.String())
+		if r.String() != "REVOKE GRANT OPTION FOR SELECT ON ALL TABLES IN SCHEMA app FROM manuel CASCADE;" {
+			t.Fatal("wrong revoke grant option")
+		}
+	})
+	t.Run("default_privileges", func(t *testing.T) {
+		d := AlterDefaultPrivileges(Grant(PrivilegeSelect).On(PrivilegeOnTables).To("reader")).ForRole("app_owner").InSchema("app")
+		t.Log(d.String())
+		if d.String() != "ALTER DEFAULT PRIVILEGES FOR ROLE app_owner IN SCHEMA app GRANT SELECT ON TABLES TO reader;" {
+			t.Fatal("wrong default_privileges")
+		}
+		d = AlterDefaultPrivileges(Revoke(PrivilegeExecute).On(PrivilegeOnFunctions).From("PUBLIC"))
+		if d.String() != "ALTER DEFAULT PRIVILEGES REVOKE EXECUTE ON FUNCTIONS FROM PUBLIC;" {
+			t.Fatal("wrong revoke default_privileges")
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		if Grant(PrivilegeSelect).OnTable("films").String() != "" || !AlterDefaultPrivileges(nil).IsEmpty() {
+			t.Fatal("must be empty")
+		}
+		if Grant(PrivilegeSelect).On("TABLE").To("reader").String() != "" ||
+			Grant(PrivilegeSelect).OnAllTablesInSchema().To("reader").String() != "" {
+			t.Fatal("must be empty without names")
+		}
+	})
+	t.Run("column_without_columns", func(t *testing.T) {
+		g := Grant(PrivilegeSelect).Column(PrivilegeUpdate).OnTable("films").To("PUBLIC")
+		t.Log(g.String())
+		if g.String() != "GRANT SELECT ON TABLE films TO PUBLIC;" {
+			t.Fatal("wrong column_without_columns")
+		}
+	})
+	t.Run("default_privileges_invalid_object", func(t *testing.T) {
+		if AlterDefaultPrivileges(Grant(PrivilegeSelect).OnTable("films").To("reader")).String() != "" ||
+			AlterDefaultPrivileges(Grant(PrivilegeSelect).On(PrivilegeOnTables, "films").To("reader")).String() != "" ||
+			AlterDefaultPrivileges(Grant(PrivilegeSelect).On("VIEWS").To("reader")).String() != "" ||
+			AlterDefaultPrivileges(Grant(PrivilegeSelect).On(PrivilegeOnTables)).String() != "" {
+			t.Fatal("must be empty")
+		}
+	})
+}
+
+func TestRole_String(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		r := CreateRole("miriam").Login().Password("jw8s0F4").ValidUntil("2025-01-01").ConnectionLimit(10).InRole("readers")
+		t.Log(r.String())
+		if r.String() != "CREATE ROLE miriam WITH LOGIN PASSWORD 'jw8s0F4' VALID UNTIL '2025-01-01' CONNECTION LIMIT 10 IN ROLE readers;" {
+			t.Fatal("wrong create")
+		}
+		if CreateRole("readers").String() != "CREATE ROLE readers;" {
+			t.Fatal("wrong create simple")
+		}
+		if CreateRole("app").Login().Password("s3cr#t").String() != "CREATE ROLE app WITH LOGIN PASSWORD 's3cr#t';" {
+			t.Fatal("wrong create password with #")
+		}
+	})
+	t.Run("drop", func(t *testing.T) {
+		if DropRole("miriam", "readers").IfExists().String() != "DROP ROLE IF EXISTS miriam, readers;" {
+			t.Fatal("wrong drop")
+		}
+		if DropRole("miriam").Cascade().String() != "DROP ROLE miriam;" {
+			t.Fatal("drop role must not render cascade")
+		}
+	})
+}
@@ -0,0 +1,136 @@
+package gosql
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Role create role query builder
+// CREATE ROLE name [ [ WITH ] option [ ... ] ]
+//
+// where option can be:
+//
+//	SUPERUSER | NOSUPERUSER
+//	| CREATEDB | NOCREATEDB
+//	| CREATEROLE | NOCREATEROLE
+//	| INHERIT | NOINHERIT
+//	| LOGIN | NOLOGIN
+//	| REPLICATION | NOREPLICATION
+//	| BYPASSRLS | NOBYPASSRLS
+//	| CONNECTION LIMIT connlimit
+//	| [ ENCRYPTED ] PASSWORD 'password' | PASSWORD NULL
+//	| VALID UNTIL 'timestamp'
+//	| IN ROLE role_name [, ...]
+//	| ROLE role_name [, ...]
+//	| ADMIN role_name [, ...]
+type Role struct {
+	// role name
+	name string
+	// options
+	options []string
+}
+
+// Superuser role is superuser
+func (r *Role) Superuser() *Role {
+	r.options = append(r.options, "SUPERUSER")
+	return r
+}
+
+// CreateDB role can create databases
+func (r *Role) CreateDB() *Role {
+	r.options = append(r.options, "CREATEDB")
+	return r
+}
+
+// CreateRole role can create roles
+func (r *Role) CreateRole() *Role {
+	r.options = append(r.options, "CREATEROLE")
+	return r
+}
+
+// NoInherit role does not inherit privileges of its member roles
+func (r *Role) NoInherit() *Role {
+	r.options = append(r.options, "NOINHERIT")
+	return r
+}
+
+// Login role is allowed to log in
+func (r *Role) Login() *Role {
+	r.options = append(r.options, "LOGIN")
+	return r
+}
+
+// Replication role is replication role
+func (r *Role) Replication() *Role {
+	r.options = append(r.options, "REPLICATION")
+	return r
+}
+
+// BypassRLS role bypasses row level security policies
+func (r *Role) BypassRLS() *Role {
+	r.options = append(r.options, "BYPASSRLS")
+	return r
+}
+
+// ConnectionLimit set number of concurrent connections. -1 means no limit
+func (r *Role) ConnectionLimit(limit int) *Role {
+	r.options = append(r.options, "CONNECTION LIMIT "+strconv.Itoa(limit))
+	return r
+}
+
+// Password set password
+func (r *Role) Password(password string) *Role {
+	r.options = append(r.options, "PASSWORD "+quoteLiteral(password))
+	return r
+}
+
+// ValidUntil set password expiration time. Example: 2025-01-01
+func (r *Role) ValidUntil(timestamp string) *Role {
+	r.options = append(r.options, "VALID UNTIL "+quoteLiteral(timestamp))
+	return r
+}
+
+// InRole add new role as member of roles
+func (r *Role) InRole(role ...string) *Role {
+	r.options = append(r.options, "IN ROLE "+strings.Join(role, ", "))
+	return r
+}
+
+// Role add roles as members of new role
+func (r *Role) Role(role ...string) *Role {
+	r.options = append(r.options, "ROLE "+strings.Join(role, ", "))
+	return r
+}
+
+// Admin add roles as members of new role with admin option
+func (r *Role) Admin(role ...string) *Role {
+	r.options = append(r.options, "ADMIN "+strings.Join(role, ", "))
+	return r
+}
+
+// IsEmpty check if empty
+func (r *Role) IsEmpty() bool {
+	return r == nil || r.name == ""
+}
+
+// String render create role query
+func (r *Role) String() string {
+	if r.IsEmpty() {
+		return ""
+	}
+	if len(r.options) == 0 {
+		return "CREATE ROLE " + r.name + ";"
+	}
+	return "CREATE ROLE " + r.name + " WITH " + strings.Join(r.options, " ") + ";"
+}
+
+// SQL common sql interface
+func (r *Role) SQL() (query string, params []any, returning []any) {
+	query = r.String()
+	return
+}
+
+// CreateRole create role constructor
+func CreateRole(name string) *Role {
+	return &Role{name: name}
+}
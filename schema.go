@@ -0,0 +1,221 @@
+package gosql
+
+import "strings"
+
+// Schema create or alter schema query builder
+// CREATE SCHEMA [ IF NOT EXISTS ] schema_name [ AUTHORIZATION role_specification ]
+//
+// CREATE SCHEMA [ IF NOT EXISTS ] AUTHORIZATION role_specification
+//
+// ALTER SCHEMA name RENAME TO new_name
+//
+// ALTER SCHEMA name OWNER TO { new_owner | CURRENT_ROLE | CURRENT_USER | SESSION_USER }
+type Schema struct {
+	// is alter query
+	alter bool
+	// schema name
+	name string
+	// if not exists
+	ifNotExists bool
+	// owner role
+	authorization string
+	// new name
+	rename string
+	// new owner
+	owner string
+}
+
+// IfNotExists do not throw an error if schema exists
+func (s *Schema) IfNotExists() *Schema {
+	s.ifNotExists = true
+	return s
+}
+
+// Authorization set owner role. Schema is named as role if name is empty
+func (s *Schema) Authorization(role string) *Schema {
+	s.authorization = role
+	return s
+}
+
+// Rename schema
+func (s *Schema) Rename(name string) *Schema {
+	s.rename = name
+	return s
+}
+
+// OwnerTo change owner of schema
+func (s *Schema) OwnerTo(role string) *Schema {
+	s.owner = role
+	return s
+}
+
+// IsEmpty check if empty. Alter schema is empty without rename or owner
+func (s *Schema) IsEmpty() bool {
+	if s == nil {
+		return true
+	}
+	if s.alter {
+		return s.name == "" || (s.rename == "" && s.owner == "")
+	}
+	return s.name == "" && s.authorization == ""
+}
+
+// String render schema query
+func (s *Schema) String() string {
+	if s.IsEmpty() {
+		return ""
+	}
+	b := strings.Builder{}
+	if s.alter {
+		b.WriteString("ALTER SCHEMA " + s.name)
+		if s.rename != "" {
+			b.WriteString(" RENAME TO " + s.rename)
+		} else if s.owner != "" {
+			b.WriteString(" OWNER TO " + s.owner)
+		}
+		return b.String() + ";"
+	}
+	b.WriteString("CREATE SCHEMA")
+	if s.ifNotExists {
+		b.WriteString(" IF NOT EXISTS")
+	}
+	if s.name != "" {
+		b.WriteString(" " + s.name)
+	}
+	if s.authorization != "" {
+		b.WriteString(" AUTHORIZATION " + s.authorization)
+	}
+	return b.String() + ";"
+}
+
+// SQL common sql interface
+func (s *Schema) SQL() (query string, params []any, returning []any) {
+	query = s.String()
+	return
+}
+
+// CreateSchema create schema constructor
+func CreateSchema(name string) *Schema {
+	return &Schema{name: name}
+}
+
+// AlterSchema alter schema constructor
+func AlterSchema(name string) *Schema {
+	return &Schema{name: name, alter: true}
+}
+
+// Extension create or alter extension query builder
+// CREATE EXTENSION [ IF NOT EXISTS ] extension_name [ WITH ] [ SCHEMA schema_name ] [ VERSION version ] [ CASCADE ]
+//
+// ALTER EXTENSION name UPDATE [ TO new_version ]
+//
+// ALTER EXTENSION name SET SCHEMA new_schema
+type Extension struct {
+	// is alter query
+	alter bool
+	// extension name
+	name string
+	// if not exists
+	ifNotExists bool
+	// schema
+	schema string
+	// version
+	version string
+	// update
+	update bool
+	// install dependencies
+	cascade bool
+}
+
+// IfNotExists do not throw an error if extension exists
+func (e *Extension) IfNotExists() *Extension {
+	e.ifNotExists = true
+	return e
+}
+
+// Schema set schema of extension objects
+func (e *Extension) Schema(schema string) *Extension {
+	e.schema = schema
+	return e
+}
+
+// Version set version of extension
+func (e *Extension) Version(version string) *Extension {
+	e.version = version
+	return e
+}
+
+// Cascade install extensions this extension depends on
+func (e *Extension) Cascade() *Extension {
+	e.cascade = true
+	return e
+}
+
+// Update update extension to default version
+func (e *Extension) Update() *Extension {
+	e.update = true
+	return e
+}
+
+// UpdateTo update extension to version
+func (e *Extension) UpdateTo(version string) *Extension {
+	e.update = true
+	e.version = version
+	return e
+}
+
+// IsEmpty check if empty. Alter extension is empty without update or schema
+func (e *Extension) IsEmpty() bool {
+	return e == nil || e.name == "" || (e.alter && !e.update && e.schema == "")
+}
+
+// String render extension query
+func (e *Extension) String() string {
+	if e.IsEmpty() {
+		return ""
+	}
+	b := strings.Builder{}
+	if e.alter {
+		b.WriteString("ALTER EXTENSION " + e.name)
+		if e.update {
+			b.WriteString(" UPDATE")
+			if e.version != "" {
+				b.WriteString(" TO " + quoteLiteral(e.version))
+			}
+		} else if e.schema != "" {
+			b.WriteString(" SET SCHEMA " + e.schema)
+		}
+		return b.String() + ";"
+	}
+	b.WriteString("CREATE EXTENSION")
+	if e.ifNotExists {
+		b.WriteString(" IF NOT EXISTS")
+	}
+	b.WriteString(" " + e.name)
+	if e.schema != "" {
+		b.WriteString(" WITH SCHEMA " + e.schema)
+	}
+	if e.version != "" {
+		b.WriteString(" VERSION " + quoteLiteral(e.version))
+	}
+	if e.cascade {
+		b.WriteString(" CASCADE")
+	}
+	return b.String() + ";"
+}
+
+// SQL common sql interface
+func (e *Extension) SQL() (query string, params []any, returning []any) {
+	query = e.String()
+	return
+}
+
+// CreateExtension create extension constructor
+func CreateExtension(name string) *Extension {
+	return &Extension{name: name}
+}
+
+// AlterExtension alter extension constructor
+func AlterExtension(name string) *Extension {
+	return &Extension{name: name, alter: true}
+}
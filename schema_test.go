@@ -0,0 +1,65 @@
+package gosql
+
+import "testing"
+
+func TestSchema_String(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		s := CreateSchema("app").IfNotExists().Authorization("app_owner")
+		t.Log(s.String())
+		if s.String() != "CREATE SCHEMA IF NOT EXISTS app AUTHORIZATION app_owner;" {
+			t.Fatal("wrong create")
+		}
+	})
+	t.Run("create_authorization", func(t *testing.T) {
+		s := CreateSchema("").Authorization("joe")
+		t.Log(s.String())
+		if s.String() != "CREATE SCHEMA AUTHORIZATION joe;" {
+			t.Fatal("wrong create_authorization")
+		}
+	})
+	t.Run("alter", func(t *testing.T) {
+		if AlterSchema("app").Rename("core").String() != "ALTER SCHEMA app RENAME TO core;" ||
+			AlterSchema("app").OwnerTo("CURRENT_USER").String() != "ALTER SCHEMA app OWNER TO CURRENT_USER;" {
+			t.Fatal("wrong alter")
+		}
+	})
+	t.Run("alter_empty", func(t *testing.T) {
+		s := AlterSchema("app")
+		if !s.IsEmpty() || s.String() != "" {
+			t.Fatal("alter without action must be empty")
+		}
+	})
+}
+
+func TestExtension_String(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		e := CreateExtension("pgcrypto").IfNotExists().Schema("app").Version("1.3").Cascade()
+		t.Log(e.String())
+		if e.String() != "CREATE EXTENSION IF NOT EXISTS pgcrypto WITH SCHEMA app VERSION '1.3' CASCADE;" {
+			t.Fatal("wrong create")
+		}
+	})
+	t.Run("alter", func(t *testing.T) {
+		if AlterExtension("hstore").UpdateTo("2.0").String() != "ALTER EXTENSION hstore UPDATE TO '2.0';" ||
+			AlterExtension("hstore").Update().String() != "ALTER EXTENSION hstore UPDATE;" ||
+			AlterExtension("hstore").Schema("utils").String() != "ALTER EXTENSION hstore SET SCHEMA utils;" {
+			t.Fatal("wrong alter")
+		}
+	})
+	t.Run("alter_empty", func(t *testing.T) {
+		e := AlterExtension("hstore").Version("2.0")
+		if !e.IsEmpty() || e.String() != "" {
+			t.Fatal("alter without action must be empty")
+		}
+	})
+	t.Run("bootstrap", func(t *testing.T) {
+		query, _, _ := SQList{
+			CreateSchema("app").IfNotExists().Authorization("app_owner"),
+			CreateExtension("pgcrypto").IfNotExists().Schema("app"),
+		}.Join()
+		t.Log(query)
+		if query != "CREATE SCHEMA IF NOT EXISTS app AUTHORIZATION app_owner;CREATE EXTENSION IF NOT EXISTS pgcrypto WITH SCHEMA app;" {
+			t.Fatal("wrong bootstrap")
+		}
+	})
+}
@@ -32,6 +32,8 @@ type Select struct {
 	group []string
 	// union expressions
 	union []*Select
+	// union all flags of union expressions
+	unionAll []bool
 	// except expressions
 	except []*Select
 	// intersect expressions
@@ -58,6 +60,16 @@ func (q *Select) With() *with {
 func (q *Select) Union(s *Select) *Select {
 	if s != nil {
 		q.union = append(q.union, s)
+		q.unionAll = append(q.unionAll, false)
+	}
+	return q
+}
+
+// UnionAll add union all. Duplicate rows are not removed
+func (q *Select) UnionAll(s *Select) *Select {
+	if s != nil {
+		q.union = append(q.union, s)
+		q.unionAll = append(q.unionAll, true)
 	}
 	return q
 }
@@ -87,6 +99,7 @@ func (q *Select) ResetIntersect() *Select {
 // ResetUnion reset union
 func (q *Select) ResetUnion() *Select {
 	q.union = make([]*Select, 0)
+	q.unionAll = nil
 	return q
 }
 
@@ -167,12 +180,7 @@ func (q *Select) SetPagination(limit int, offset int) *Select {
 
 // Get arguments
 func (q *Select) GetArguments() []interface{} {
-	arguments := make([]interface{}, 0)
-	if q.with.Len() > 0 {
-		for _, w := range q.with.queries {
-			arguments = append(arguments, w.GetArguments()...)
-		}
-	}
+	arguments := q.with.GetArguments()
 
 	arguments = append(arguments, append(q.where.GetArguments(), q.having.GetArguments()...)...)
 
@@ -246,8 +254,12 @@ func (q *Select) String() string {
 	}
 
 	// Union render
-	for _, u := range q.union {
-		b.WriteString(" UNION " + u.String())
+	for j, u := range q.union {
+		if j < len(q.unionAll) && q.unionAll[j] {
+			b.WriteString(" UNION ALL " + u.String())
+		} else {
+			b.WriteString(" UNION " + u.String())
+		}
 	}
 
 	// Except render
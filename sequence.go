@@ -0,0 +1,339 @@
+package gosql
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	// sequenceCreate options of create sequence
+	sequenceCreate = iota
+	// sequenceAlter options of alter sequence
+	sequenceAlter
+	// sequenceIdentity options of identity column
+	sequenceIdentity
+	// sequenceAlterIdentity options of alter identity column
+	sequenceAlterIdentity
+)
+
+// sequence options
+// Options not applicable to create sequence, alter sequence or identity column are not rendered there
+// [ SEQUENCE NAME name ] for identity column only
+// [ AS data_type ]
+// [ INCREMENT [ BY ] increment ]
+// [ MINVALUE minvalue | NO MINVALUE ] [ MAXVALUE maxvalue | NO MAXVALUE ]
+// [ START [ WITH ] start ] [ RESTART [ [ WITH ] restart ] ] [ CACHE cache ] [ [ NO ] CYCLE ]
+// [ OWNED BY { table_name.column_name | NONE } ]
+type sequenceOptions struct {
+	// sequence name for identity column
+	name string
+	// data type
+	dataType string
+	// increment
+	increment string
+	// min value
+	minValue string
+	// max value
+	maxValue string
+	// start
+	start string
+	// restart
+	restart string
+	// cache
+	cache string
+	// cycle
+	cycle string
+	// owned by
+	ownedBy string
+}
+
+// SequenceName set sequence name of identity column
+func (s *sequenceOptions) SequenceName(name string) *sequenceOptions {
+	s.name = name
+	return s
+}
+
+// As set data type. Example: bigint
+func (s *sequenceOptions) As(dataType string) *sequenceOptions {
+	s.dataType = dataType
+	return s
+}
+
+// Increment set increment
+func (s *sequenceOptions) Increment(increment int64) *sequenceOptions {
+	s.increment = strconv.FormatInt(increment, 10)
+	return s
+}
+
+// MinValue set min value
+func (s *sequenceOptions) MinValue(value int64) *sequenceOptions {
+	s.minValue = "MINVALUE " + strconv.FormatInt(value, 10)
+	return s
+}
+
+// NoMinValue use default min value
+func (s *sequenceOptions) NoMinValue() *sequenceOptions {
+	s.minValue = "NO MINVALUE"
+	return s
+}
+
+// MaxValue set max value
+func (s *sequenceOptions) MaxValue(value int64) *sequenceOptions {
+	s.maxValue = "MAXVALUE " + strconv.FormatInt(value, 10)
+	return s
+}
+
+// NoMaxValue use default max value
+func (s *sequenceOptions) NoMaxValue() *sequenceOptions {
+	s.maxValue = "NO MAXVALUE"
+	return s
+}
+
+// Start set start value
+func (s *sequenceOptions) Start(value int64) *sequenceOptions {
+	s.start = strconv.FormatInt(value, 10)
+	return s
+}
+
+// Restart restart with start value
+func (s *sequenceOptions) Restart() *sequenceOptions {
+	s.restart = "RESTART"
+	return s
+}
+
+// RestartWith restart with value
+func (s *sequenceOptions) RestartWith(value int64) *sequenceOptions {
+	s.restart = "RESTART WITH " + strconv.FormatInt(value, 10)
+	return s
+}
+
+// Cache set cache size
+func (s *sequenceOptions) Cache(cache int64) *sequenceOptions {
+	s.cache = strconv.FormatInt(cache, 10)
+	return s
+}
+
+// Cycle wrap around on limit
+func (s *sequenceOptions) Cycle() *sequenceOptions {
+	s.cycle = "CYCLE"
+	return s
+}
+
+// NoCycle throw an error on limit
+func (s *sequenceOptions) NoCycle() *sequenceOptions {
+	s.cycle = "NO CYCLE"
+	return s
+}
+
+// OwnedBy set owner column. Example: table_name.column_name
+func (s *sequenceOptions) OwnedBy(column string) *sequenceOptions {
+	s.ownedBy = column
+	return s
+}
+
+// OwnedByNone remove owner column
+func (s *sequenceOptions) OwnedByNone() *sequenceOptions {
+	s.ownedBy = "NONE"
+	return s
+}
+
+// IsEmpty check if empty
+func (s *sequenceOptions) IsEmpty() bool {
+	return s == nil || len(s.items(-1)) == 0
+}
+
+// items rendered options in order. All options are rendered if context is negative
+// Sequence name is only for identity column, restart is not for create, owned by is not for identity column
+func (s *sequenceOptions) items(context int) []string {
+	if s == nil {
+		return nil
+	}
+	items := make([]string, 0, 4)
+	if s.name != "" && (context < 0 || context == sequenceIdentity) {
+		items = append(items, "SEQUENCE NAME "+s.name)
+	}
+	if s.dataType != "" {
+		items = append(items, "AS "+s.dataType)
+	}
+	if s.increment != "" {
+		items = append(items, "INCREMENT BY "+s.increment)
+	}
+	if s.minValue != "" {
+		items = append(items, s.minValue)
+	}
+	if s.maxValue != "" {
+		items = append(items, s.maxValue)
+	}
+	if s.start != "" {
+		items = append(items, "START WITH "+s.start)
+	}
+	if s.restart != "" && context != sequenceCreate && context != sequenceIdentity {
+		items = append(items, s.restart)
+	}
+	if s.cache != "" {
+		items = append(items, "CACHE "+s.cache)
+	}
+	if s.cycle != "" {
+		items = append(items, s.cycle)
+	}
+	if s.ownedBy != "" && context != sequenceIdentity && context != sequenceAlterIdentity {
+		items = append(items, "OWNED BY "+s.ownedBy)
+	}
+	return items
+}
+
+// String render options
+func (s *sequenceOptions) String() string {
+	return strings.Join(s.items(-1), " ")
+}
+
+// render options applicable to context
+func (s *sequenceOptions) render(context int) string {
+	return strings.Join(s.items(context), " ")
+}
+
+// alterColumnString render options for alter column. Each option except restart is prefixed with SET
+func (s *sequenceOptions) alterColumnString() string {
+	items := s.items(sequenceAlterIdentity)
+	for i := range items {
+		if !strings.HasPrefix(items[i], "RESTART") {
+			items[i] = "SET " + items[i]
+		}
+	}
+	return strings.Join(items, " ")
+}
+
+// NewSequenceOptions sequence options constructor
+func NewSequenceOptions() *sequenceOptions {
+	return &sequenceOptions{}
+}
+
+// Sequence create or alter sequence query builder
+// CREATE [ { TEMPORARY | TEMP } | UNLOGGED ] SEQUENCE [ IF NOT EXISTS ] name [ sequence_options ]
+//
+// ALTER SEQUENCE [ IF EXISTS ] name [ sequence_options ]
+// ALTER SEQUENCE [ IF EXISTS ] name RENAME TO new_name
+// ALTER SEQUENCE [ IF EXISTS ] name SET SCHEMA new_schema
+type Sequence struct {
+	// is alter query
+	alter bool
+	// sequence name
+	name string
+	// temporary
+	temp bool
+	// unlogged
+	unLogged bool
+	// if not exists
+	ifNotExists bool
+	// if exists
+	ifExists bool
+	// options
+	options sequenceOptions
+	// new name
+	rename string
+	// new schema
+	schema string
+}
+
+// Temp temporary sequence
+func (s *Sequence) Temp() *Sequence {
+	s.temp = true
+	return s
+}
+
+// UnLogged unlogged sequence
+func (s *Sequence) UnLogged() *Sequence {
+	s.unLogged = true
+	return s
+}
+
+// IfNotExists do not throw an error if sequence exists
+func (s *Sequence) IfNotExists() *Sequence {
+	s.ifNotExists = true
+	return s
+}
+
+// IfExists do not throw an error if sequence does not exist
+func (s *Sequence) IfExists() *Sequence {
+	s.ifExists = true
+	return s
+}
+
+// Options get sequence options
+func (s *Sequence) Options() *sequenceOptions {
+	return &s.options
+}
+
+// Rename sequence
+func (s *Sequence) Rename(name string) *Sequence {
+	s.rename = name
+	return s
+}
+
+// SetSchema move sequence to schema
+func (s *Sequence) SetSchema(schema string) *Sequence {
+	s.schema = schema
+	return s
+}
+
+// IsEmpty check if empty. Alter sequence is empty without rename, schema or options
+func (s *Sequence) IsEmpty() bool {
+	if s == nil || s.name == "" {
+		return true
+	}
+	return s.alter && s.rename == "" && s.schema == "" && s.options.render(sequenceAlter) == ""
+}
+
+// String render sequence query
+func (s *Sequence) String() string {
+	if s.IsEmpty() {
+		return ""
+	}
+	b := strings.Builder{}
+	if s.alter {
+		b.WriteString("ALTER SEQUENCE")
+		if s.ifExists {
+			b.WriteString(" IF EXISTS")
+		}
+	} else {
+		b.WriteString("CREATE")
+		if s.temp {
+			b.WriteString(" TEMPORARY")
+		} else if s.unLogged {
+			b.WriteString(" UNLOGGED")
+		}
+		b.WriteString(" SEQUENCE")
+		if s.ifNotExists {
+			b.WriteString(" IF NOT EXISTS")
+		}
+	}
+	b.WriteString(" " + s.name)
+	if s.alter && s.rename != "" {
+		b.WriteString(" RENAME TO " + s.rename)
+	} else if s.alter && s.schema != "" {
+		b.WriteString(" SET SCHEMA " + s.schema)
+	} else if s.alter {
+		if options := s.options.render(sequenceAlter); options != "" {
+			b.WriteString(" " + options)
+		}
+	} else if options := s.options.render(sequenceCreate); options != "" {
+		b.WriteString(" " + options)
+	}
+	return b.String() + ";"
+}
+
+// SQL common sql interface
+func (s *Sequence) SQL() (query string, params []any, returning []any) {
+	query = s.String()
+	return
+}
+
+// CreateSequence create sequence constructor
+func CreateSequence(name string) *Sequence {
+	return &Sequence{name: name}
+}
+
+// AlterSequence alter sequence constructor
+func AlterSequence(name string) *Sequence {
+	return &Sequence{name: name, alter: true}
+}
@@ -0,0 +1,124 @@
+package gosql
+
+import "testing"
+
+func TestSequence_String(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		s := CreateSequence("serial").IfNotExists()
+		s.Options().As("bigint").Increment(1).MinValue(1).NoMaxValue().Start(101).Cache(20).NoCycle().OwnedBy("orders.id")
+		t.Log(s.String())
+		if s.String() != "CREATE SEQUENCE IF NOT EXISTS serial AS bigint INCREMENT BY 1 MINVALUE 1 NO MAXVALUE START WITH 101 CACHE 20 NO CYCLE OWNED BY orders.id;" {
+			t.Fatal("wrong create")
+		}
+	})
+	t.Run("create_temp", func(t *testing.T) {
+		s := CreateSequence("serial").Temp()
+		t.Log(s.String())
+		if s.String() != "CREATE TEMPORARY SEQUENCE serial;" {
+			t.Fatal("wrong create_temp")
+		}
+	})
+	t.Run("alter", func(t *testing.T) {
+		s := AlterSequence("serial").IfExists()
+		s.Options().RestartWith(105).Cycle().OwnedByNone()
+		t.Log(s.String())
+		if s.String() != "ALTER SEQUENCE IF EXISTS serial RESTART WITH 105 CYCLE OWNED BY NONE;" {
+			t.Fatal("wrong alter")
+		}
+	})
+	t.Run("alter_rename", func(t *testing.T) {
+		s := AlterSequence("serial").Rename("serial_new")
+		t.Log(s.String())
+		if s.String() != "ALTER SEQUENCE serial RENAME TO serial_new;" {
+			t.Fatal("wrong alter_rename")
+		}
+	})
+	t.Run("table_identity", func(t *testing.T) {
+		table := CreateTable("distributors")
+		did := table.AddColumn("did").Type("integer")
+		did.Constraint().Generated().SetDetail(GeneratedAlways)
+		did.Constraint().Identity().SequenceName("distributors_did_seq").Start(1000).Cache(10)
+		t.Log(table.String())
+		if table.String() != "CREATE TABLE distributors (did integer GENERATED ALWAYS AS IDENTITY (SEQUENCE NAME distributors_did_seq START WITH 1000 CACHE 10));" {
+			t.Fatal("wrong table_identity")
+		}
+	})
+	t.Run("alter_table_identity", func(t *testing.T) {
+		alter := AlterTable("distributors")
+		alter.Action().AlterColumn("did").Add().GeneratedByDefault().Identity().Start(10).Increment(2)
+		t.Log(alter.String())
+		if alter.String() != "ALTER TABLE distributors ALTER COLUMN did ADD GENERATED BY DEFAULT AS IDENTITY (INCREMENT BY 2 START WITH 10);" {
+			t.Fatal("wrong alter_table_identity")
+		}
+	})
+	t.Run("alter_table_set_options", func(t *testing.T) {
+		alter := AlterTable("distributors")
+		alter.Action().AlterColumn("did").Set().GeneratedAlways(0).SequenceOptions(1, NewSequenceOptions().Increment(5).RestartWith(100))
+		t.Log(alter.String())
+		if alter.String() != "ALTER TABLE distributors ALTER COLUMN did SET GENERATED ALWAYS SET INCREMENT BY 5 RESTART WITH 100;" {
+			t.Fatal("wrong alter_table_set_options")
+		}
+	})
+	t.Run("create_inapplicable_options", func(t *testing.T) {
+		s := CreateSequence("serial")
+		s.Options().SequenceName("ignored").Start(1).RestartWith(5)
+		t.Log(s.String())
+		if s.String() != "CREATE SEQUENCE serial START WITH 1;" {
+			t.Fatal("wrong create_inapplicable_options")
+		}
+	})
+	t.Run("alter_inapplicable_options", func(t *testing.T) {
+		s := AlterSequence("serial")
+		s.Options().SequenceName("ignored").RestartWith(5)
+		t.Log(s.String())
+		if s.String() != "ALTER SEQUENCE serial RESTART WITH 5;" {
+			t.Fatal("wrong alter_inapplicable_options")
+		}
+	})
+	t.Run("identity_inapplicable_options", func(t *testing.T) {
+		table := CreateTable("distributors")
+		did := table.AddColumn("did").Type("integer")
+		did.Constraint().Generated().SetDetail(GeneratedAlways)
+		did.Constraint().Identity().Start(1000).RestartWith(5).OwnedBy("orders.id")
+		t.Log(table.String())
+		if table.String() != "CREATE TABLE distributors (did integer GENERATED ALWAYS AS IDENTITY (START WITH 1000));" {
+			t.Fatal("wrong identity_inapplicable_options")
+		}
+		alter := AlterTable("distributors")
+		alter.Action().AlterColumn("did").Add().GeneratedByDefault().Identity().RestartWith(5).OwnedBy("orders.id")
+		t.Log(alter.String())
+		if alter.String() != "ALTER TABLE distributors ALTER COLUMN did ADD GENERATED BY DEFAULT AS IDENTITY;" {
+			t.Fatal("wrong identity_inapplicable_options add")
+		}
+	})
+	t.Run("alter_table_set_inapplicable_options", func(t *testing.T) {
+		alter := AlterTable("distributors")
+		alter.Action().AlterColumn("did").Set().GeneratedAlways(0).SequenceOptions(1, NewSequenceOptions().Increment(5).OwnedBy("orders.id"))
+		t.Log(alter.String())
+		if alter.String() != "ALTER TABLE distributors ALTER COLUMN did SET GENERATED ALWAYS SET INCREMENT BY 5;" {
+			t.Fatal("wrong alter_table_set_inapplicable_options")
+		}
+	})
+	t.Run("alter_empty", func(t *testing.T) {
+		s := AlterSequence("serial")
+		if !s.IsEmpty() || s.String() != "" {
+			t.Fatal("alter without action must be empty")
+		}
+		s.Options().SequenceName("ignored")
+		if !s.IsEmpty() || s.String() != "" {
+			t.Fatal("alter with inapplicable options must be empty")
+		}
+	})
+	t.Run("if_exists_separate", func(t *testing.T) {
+		s := CreateSequence("serial").IfExists()
+		t.Log(s.String())
+		if s.String() != "CREATE SEQUENCE serial;" {
+			t.Fatal("wrong if_exists_separate create")
+		}
+		s = AlterSequence("serial").IfNotExists().Rename("serial_new")
+		t.Log(s.String())
+		if s.String() != "ALTER SEQUENCE serial RENAME TO serial_new;" {
+			t.Fatal("wrong if_exists_separate alter")
+		}
+	})
+}
@@ -0,0 +1,104 @@
+package gosql
+
+import "strings"
+
+// SoftDeleteColumn default soft delete column
+const SoftDeleteColumn = "deleted_at"
+
+// SoftDelete soft delete policy
+// Delete query turns into update of delete column, select queries skip deleted rows
+type SoftDelete struct {
+	// delete column
+	column string
+	// delete value expression
+	value string
+	// do not filter deleted rows
+	withDeleted bool
+}
+
+// Value set delete value expression. Default is now()
+func (s *SoftDelete) Value(value string) *SoftDelete {
+	s.value = value
+	return s
+}
+
+// WithDeleted copy of policy that does not filter deleted rows
+func (s *SoftDelete) WithDeleted() *SoftDelete {
+	policy := *s
+	policy.withDeleted = true
+	return &policy
+}
+
+// column reference with optional alias
+func (s *SoftDelete) reference(alias string) string {
+	if alias == "" {
+		return s.column
+	}
+	return alias + "." + s.column
+}
+
+// notDeleted condition for not deleted rows
+func (s *SoftDelete) notDeleted(alias string) string {
+	return s.reference(alias) + " IS NULL"
+}
+
+// filter not deleted condition of tables by aliases
+// Unqualified column is used when no alias provided
+func (s *SoftDelete) filter(aliases ...string) *Condition {
+	cond := NewSqlCondition(ConditionOperatorAnd)
+	if len(aliases) == 0 {
+		cond.AddExpression(s.notDeleted(""))
+	}
+	for _, alias := range aliases {
+		cond.AddExpression(s.notDeleted(alias))
+	}
+	return cond
+}
+
+// Update convert delete query into update of delete column
+// Using, where and returning are preserved. Already deleted rows are skipped
+func (s *SoftDelete) Update(d *Delete) *Update {
+	u := NewUpdate().Table(d.from).Alias(d.alias)
+	u.only = d.only
+	u.with.copyFrom(&d.with)
+	u.Set().Add(s.column + " = " + s.value)
+	u.from.copyFrom(&d.using)
+	u.cursor = d.cursor
+	where := d.condition()
+	u.where = andCondition(&where, s.filter(d.reference()))
+	u.returning.copyFrom(&d.returning)
+	return u
+}
+
+// Select filter deleted rows of tables by aliases
+// Unqualified column is used when no alias provided
+func (s *SoftDelete) Select(q *Select, aliases ...string) *Select {
+	if s.withDeleted {
+		return q
+	}
+	where := andCondition(q.Where(), s.filter(aliases...))
+	q.Where().Replace(&where)
+	return q
+}
+
+// Relate add join relation with not deleted condition of joined table
+// Relation must contain ON condition. Example: LEFT JOIN orders o ON o.user_id = u.id
+// ON condition is wrapped in parentheses before not deleted condition is added
+func (s *SoftDelete) Relate(q *Select, relation string, alias string) *Select {
+	if s.withDeleted {
+		return q.Relate(relation)
+	}
+	if i := strings.LastIndex(strings.ToUpper(relation), " ON "); i >= 0 {
+		relation = relation[:i+4] + "(" + relation[i+4:] + ")"
+	}
+	return q.Relate(relation + " AND " + s.notDeleted(alias))
+}
+
+// NewSoftDelete soft delete policy constructor
+// SoftDeleteColumn is used if column is empty
+func NewSoftDelete(column string) *SoftDelete {
+	if column == "" {
+		column = SoftDeleteColumn
+	}
+	return &SoftDelete{column: column, value: "now()"}
+}
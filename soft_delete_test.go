@@ -0,0 +1,82 @@
+package gosql
+
+import "testing"
+
+func TestSoftDelete(t *testing.T) {
+	policy := NewSoftDelete("")
+	t.Run("update", func(t *testing.T) {
+		d := NewDelete().From("films f").Using("producers p")
+		d.Where().AddExpression("f.producer_id = p.id AND p.name = ?", "foo")
+		d.Returning().Add("f.id")
+		u := policy.Update(d)
+		query, params, _ := u.SQL()
+		t.Log(query)
+		if query != "UPDATE films f SET deleted_at = now() FROM producers p WHERE (f.producer_id = p.id AND p.name = ? AND f.deleted_at IS NULL) RETURNING f.id;" {
+			t.Fatal("wrong soft delete update")
+		}
+		if len(params) != 1 || params[0] != "foo" {
+			t.Fatal("wrong soft delete params")
+		}
+		if d.String() != "DELETE FROM films f USING producers p WHERE (f.producer_id = p.id AND p.name = ?) RETURNING f.id;" {
+			t.Fatal("delete must not be changed")
+		}
+	})
+	t.Run("select", func(t *testing.T) {
+		q := NewSelect().From("users u")
+		q.Columns().Add("u.id", "o.id")
+		q.Where().AddExpression("u.name = ?", "foo")
+		policy.Relate(q, "LEFT JOIN orders o ON o.user_id = u.id", "o")
+		policy.Select(q, "u")
+		t.Log(q.String())
+		if q.String() != "SELECT u.id, o.id FROM users u LEFT JOIN orders o ON (o.user_id = u.id) AND o.deleted_at IS NULL WHERE (u.name = ? AND u.deleted_at IS NULL)" {
+			t.Fatal("wrong soft delete select")
+		}
+	})
+	t.Run("relate_or", func(t *testing.T) {
+		policy := NewSoftDelete("")
+		q := NewSelect().From("users u")
+		q.Columns().Add("u.id", "o.id")
+		policy.Relate(q, "JOIN orders o ON o.a = u.a OR o.b = u.b", "o")
+		t.Log(q.String())
+		if q.String() != "SELECT u.id, o.id FROM users u JOIN orders o ON (o.a = u.a OR o.b = u.b) AND o.deleted_at IS NULL" {
+			t.Fatal("wrong relate_or")
+		}
+	})
+	t.Run("or_where", func(t *testing.T) {
+		d := NewDelete().From("films")
+		d.Where().Merge(ConditionOperatorOr,
+			NewSqlCondition(ConditionOperatorAnd).AddExpression("kind = ?", "Drama"),
+			NewSqlCondition(ConditionOperatorAnd).AddExpression("kind = ?", "Musical"))
+		u := policy.Update(d)
+		query, params, _ := u.SQL()
+		t.Log(query)
+		if query != "UPDATE films SET deleted_at = now() WHERE (((kind = ?) OR (kind = ?)) AND (films.deleted_at IS NULL));" {
+			t.Fatal("wrong soft delete update with or where")
+		}
+		if len(params) != 2 || params[1] != "Musical" {
+			t.Fatal("wrong soft delete update with or where params")
+		}
+		q := NewSelect().From("films")
+		q.Columns().Add("id")
+		q.Where().Merge(ConditionOperatorOr,
+			NewSqlCondition(ConditionOperatorAnd).AddExpression("kind = ?", "Drama"),
+			NewSqlCondition(ConditionOperatorAnd).AddExpression("kind = ?", "Musical"))
+		policy.Select(q)
+		t.Log(q.String())
+		if q.String() != "SELECT id FROM films WHERE (((kind = ?) OR (kind = ?)) AND (deleted_at IS NULL))" || len(q.GetArguments()) != 2 {
+			t.Fatal("wrong soft delete select with or where")
+		}
+	})
+	t.Run("with_deleted", func(t *testing.T) {
+		q := NewSelect().From("users")
+		q.Columns().Add("id")
+		policy.WithDeleted().Select(q)
+		if q.String() != "SELECT id FROM users" {
+			t.Fatal("wrong with deleted select")
+		}
+		policy.Select(q)
+		if q.String() != "SELECT id FROM users WHERE (deleted_at IS NULL)" {
+			t.Fatal("policy must not be changed")
+		}
+	})
+}
@@ -35,6 +35,72 @@ var _ = ISQL(&Merge{})
 // Check Alter for ISQL
 var _ = ISQL(&Alter{})
 
+// Check Copy for ISQL
+var _ = ISQL(&Copy{})
+
+// Check Values for ISQL
+var _ = ISQL(&Values{})
+
+// Check BulkUpdate for ISQL
+var _ = ISQL(&BulkUpdate{})
+
+// Check Truncate for ISQL
+var _ = ISQL(&Truncate{})
+
+// Check Tree for ISQL
+var _ = ISQL(&Tree{})
+
+// Check Drop for ISQL
+var _ = ISQL(&Drop{})
+
+// Check View for ISQL
+var _ = ISQL(&View{})
+
+// Check MaterializedView for ISQL
+var _ = ISQL(&MaterializedView{})
+
+// Check Refresh for ISQL
+var _ = ISQL(&Refresh{})
+
+// Check Sequence for ISQL
+var _ = ISQL(&Sequence{})
+
+// Check Type for ISQL
+var _ = ISQL(&Type{})
+
+// Check TypeAlter for ISQL
+var _ = ISQL(&TypeAlter{})
+
+// Check Domain for ISQL
+var _ = ISQL(&Domain{})
+
+// Check Function for ISQL
+var _ = ISQL(&Function{})
+
+// Check Trigger for ISQL
+var _ = ISQL(&Trigger{})
+
+// Check Schema for ISQL
+var _ = ISQL(&Schema{})
+
+// Check Extension for ISQL
+var _ = ISQL(&Extension{})
+
+// Check Policy for ISQL
+var _ = ISQL(&Policy{})
+
+// Check Privilege for ISQL
+var _ = ISQL(&Privilege{})
+
+// Check DefaultPrivileges for ISQL
+var _ = ISQL(&DefaultPrivileges{})
+
+// Check Role for ISQL
+var _ = ISQL(&Role{})
+
+// Check BatchDelete for ISQL
+var _ = ISQL(&BatchDelete{})
+
 // SQList Collection of SQL element
 type SQList []ISQL
 
@@ -74,7 +74,7 @@ func (c *column) String() string {
 		b.WriteString(" COMPRESSION " + c.compression)
 	}
 	if c.collate != "" {
-		b.WriteString(" COLLATE " + c.compression)
+		b.WriteString(" COLLATE " + c.collate)
 	}
 	if c.storage != "" {
 		b.WriteString(" STORAGE " + string(c.storage))
@@ -29,6 +29,8 @@ type constraintColumn struct {
 	generatedAlwaysAs expression
 	// generated
 	generated detailedExpression
+	// identity sequence options
+	identity sequenceOptions
 	// unique index
 	unique *indexParameters
 	// primary key
@@ -82,6 +84,11 @@ func (c *constraintColumn) Generated() *detailedExpression {
 	return &c.generated
 }
 
+// Identity get sequence options of generated identity
+func (c *constraintColumn) Identity() *sequenceOptions {
+	return &c.identity
+}
+
 // Unique set unique
 func (c *constraintColumn) Unique() *indexParameters {
 	c.unique = &indexParameters{}
@@ -134,7 +141,9 @@ func (c *constraintColumn) String() string {
 	}
 	if !c.generated.IsEmpty() {
 		b.WriteString(" GENERATED " + c.generated.GetDetail() + " AS IDENTITY")
-		if c.generated.Expression().Len() > 0 {
+		if options := c.identity.render(sequenceIdentity); options != "" {
+			b.WriteString(" (" + options + ")")
+		} else if c.generated.Expression().Len() > 0 {
 			b.WriteString(" " + c.generated.Expression().String(", "))
 		}
 	}
@@ -430,6 +430,19 @@ func TestTable_String(t *testing.T) {
 			t.Fatal("wrong partition_of cities_partdef")
 		}
 	})
+	t.Run("collate", func(t *testing.T) {
+		// CREATE TABLE names (
+		//    name text COMPRESSION lz4 COLLATE "C",
+		//    title text COLLATE "de_DE"
+		// );
+		names := CreateTable("names")
+		names.AddColumn("name").Type("text").Compression("lz4").Collate(`"C"`)
+		names.AddColumn("title").Type("text").Collate(`"de_DE"`)
+		t.Log(names.String())
+		if names.String() != `CREATE TABLE names (name text COMPRESSION lz4 COLLATE "C", title text COLLATE "de_DE");` {
+			t.Fatal("wrong collate")
+		}
+	})
 }
 
 func TestTable_Definitions_Swap(t *testing.T) {
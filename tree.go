@@ -0,0 +1,195 @@
+package gosql
+
+import "strings"
+
+const (
+	// TreeDepthColumn depth of node from root
+	TreeDepthColumn = "depth"
+	// TreePathColumn array of node ids from root
+	TreePathColumn = "path"
+)
+
+// Tree recursive query for hierarchy stored by parent column
+// Path of visited ids protects from cycles
+//
+// WITH RECURSIVE tree AS (
+//
+//	SELECT id, parent_id, ..., 0 AS depth, ARRAY[id] AS path FROM table_name WHERE root_condition
+//	UNION ALL
+//	SELECT t.id, t.parent_id, ..., tree.depth + 1, tree.path || t.id FROM table_name t
+//	JOIN tree ON t.parent_id = tree.id WHERE (NOT t.id = ANY(tree.path) [AND tree.depth < max_depth])
+//
+// ) SELECT * FROM tree
+type Tree struct {
+	// table name
+	table string
+	// id column
+	id string
+	// parent column
+	parent string
+	// extra columns
+	columns []treeColumn
+	// root nodes condition
+	root Condition
+	// walk to ancestors
+	ancestors bool
+	// max depth. Unlimited if 0
+	maxDepth int
+	// with query name
+	name string
+	// table alias in recursive part
+	alias string
+}
+
+// treeColumn extra column of nodes
+type treeColumn struct {
+	// column or expression of root nodes
+	base string
+	// expression of recursive part. Qualified base column if empty
+	recursive string
+}
+
+// Columns add extra columns of nodes
+// Plain columns are qualified with table alias in recursive part, expressions are rendered as is
+// Use Expression for expressions referencing columns
+func (t *Tree) Columns(columns ...string) *Tree {
+	for _, column := range columns {
+		t.columns = append(t.columns, treeColumn{base: column})
+	}
+	return t
+}
+
+// Expression add extra expression of nodes with columns qualified by table alias in recursive part
+// Example: Expression("lower(name) AS slug", "lower(t.name)")
+func (t *Tree) Expression(base string, recursive string) *Tree {
+	t.columns = append(t.columns, treeColumn{base: base, recursive: recursive})
+	return t
+}
+
+// Root condition of start nodes
+func (t *Tree) Root() *Condition {
+	return &t.root
+}
+
+// Descendants walk from root to children. Default
+func (t *Tree) Descendants() *Tree {
+	t.ancestors = false
+	return t
+}
+
+// Ancestors walk from root to parents
+func (t *Tree) Ancestors() *Tree {
+	t.ancestors = true
+	return t
+}
+
+// MaxDepth limit depth of walk. Root depth is 0
+func (t *Tree) MaxDepth(depth int) *Tree {
+	t.maxDepth = depth
+	return t
+}
+
+// Name set with query name. Default is tree
+func (t *Tree) Name(name string) *Tree {
+	t.name = name
+	return t
+}
+
+// Alias set table alias in recursive part. Default is t
+func (t *Tree) Alias(alias string) *Tree {
+	t.alias = alias
+	return t
+}
+
+// getAlias get table alias
+func (t *Tree) getAlias() string {
+	if t.alias == "" {
+		return "t"
+	}
+	return t.alias
+}
+
+// qualify prefix plain column with alias. Column alias is kept. Example: name AS title
+func qualify(alias string, column string) string {
+	name, as, found := strings.Cut(column, " AS ")
+	if !isIdentifier(name) {
+		return column
+	}
+	if found {
+		return alias + "." + name + " AS " + as
+	}
+	return alias + "." + name
+}
+
+// isIdentifier check if expression is plain or quoted identifier
+func isIdentifier(s string) bool {
+	if len(s) > 1 && s[0] == '"' && s[len(s)-1] == '"' {
+		return !strings.Contains(s[1:len(s)-1], `"`)
+	}
+	for i, c := range s {
+		switch {
+		case c == '_', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		case i > 0 && (c >= '0' && c <= '9' || c == '$'):
+		default:
+			return false
+		}
+	}
+	return s != ""
+}
+
+// getName get with query name
+func (t *Tree) getName() string {
+	if t.name == "" {
+		return "tree"
+	}
+	return t.name
+}
+
+// Select build recursive query selecting all tree columns
+func (t *Tree) Select() *Select {
+	name := t.getName()
+	base := NewSelect().From(t.table)
+	base.Columns().Add(t.id, t.parent)
+	for _, column := range t.columns {
+		base.Columns().Add(column.base)
+	}
+	base.Columns().Add("0 AS "+TreeDepthColumn, "ARRAY["+t.id+"] AS "+TreePathColumn)
+	base.where = t.root.clone()
+
+	alias := t.getAlias()
+	recursive := NewSelect().From(t.table + " " + alias)
+	recursive.Columns().Add(alias+"."+t.id, alias+"."+t.parent)
+	for _, column := range t.columns {
+		if column.recursive != "" {
+			recursive.Columns().Add(column.recursive)
+		} else {
+			recursive.Columns().Add(qualify(alias, column.base))
+		}
+	}
+	recursive.Columns().Add(name+"."+TreeDepthColumn+" + 1", name+"."+TreePathColumn+" || "+alias+"."+t.id)
+	if t.ancestors {
+		recursive.Relate("JOIN " + name + " ON " + alias + "." + t.id + " = " + name + "." + t.parent)
+	} else {
+		recursive.Relate("JOIN " + name + " ON " + alias + "." + t.parent + " = " + name + "." + t.id)
+	}
+	recursive.Where().AddExpression("NOT " + alias + "." + t.id + " = ANY(" + name + "." + TreePathColumn + ")")
+	if t.maxDepth > 0 {
+		recursive.Where().AddExpression(name+"."+TreeDepthColumn+" < ?", t.maxDepth)
+	}
+	base.UnionAll(recursive)
+
+	q := NewSelect().From(name)
+	q.Columns().Add("*")
+	q.With().Recursive().Add(name, base)
+	return q
+}
+
+// SQL common sql interface
+func (t *Tree) SQL() (query string, params []any, returning []any) {
+	return t.Select().SQL()
+}
+
+// NewTree tree query constructor
+func NewTree(table string, id string, parent string) *Tree {
+	return &Tree{table: table, id: id, parent: parent, root: Condition{operator: ConditionOperatorAnd}}
+}
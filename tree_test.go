@@ -0,0 +1,51 @@
+package gosql
+
+import "testing"
+
+func TestTree_Select(t *testing.T) {
+	t.Run("descendants", func(t *testing.T) {
+		tree := NewTree("category", "id", "parent_id").Columns("name")
+		tree.Root().AddExpression("id = ?", 1)
+		q := tree.Select()
+		q.Where().AddExpression("depth > ?", 0)
+		q.AddOrder("path")
+		query, params, _ := q.SQL()
+		t.Log(query)
+		if query != "WITH RECURSIVE tree AS (SELECT id, parent_id, name, 0 AS depth, ARRAY[id] AS path FROM category WHERE (id = ?) UNION ALL SELECT t.id, t.parent_id, t.name, tree.depth + 1, tree.path || t.id FROM category t JOIN tree ON t.parent_id = tree.id WHERE (NOT t.id = ANY(tree.path))) SELECT * FROM tree WHERE (depth > ?) ORDER BY path" {
+			t.Fatal("wrong descendants")
+		}
+		if len(params) != 2 || params[0] != 1 || params[1] != 0 {
+			t.Fatal("wrong descendants params")
+		}
+	})
+	t.Run("ancestors", func(t *testing.T) {
+		tree := NewTree("employee", "id", "manager_id").Ancestors().MaxDepth(3).Name("chain")
+		tree.Root().AddExpression("id = ?", 10)
+		query, params, _ := tree.SQL()
+		t.Log(query)
+		if query != "WITH RECURSIVE chain AS (SELECT id, manager_id, 0 AS depth, ARRAY[id] AS path FROM employee WHERE (id = ?) UNION ALL SELECT t.id, t.manager_id, chain.depth + 1, chain.path || t.id FROM employee t JOIN chain ON t.id = chain.manager_id WHERE (NOT t.id = ANY(chain.path) AND chain.depth < ?)) SELECT * FROM chain" {
+			t.Fatal("wrong ancestors")
+		}
+		if len(params) != 2 || params[0] != 10 || params[1] != 3 {
+			t.Fatal("wrong ancestors params")
+		}
+	})
+	t.Run("alias_expressions", func(t *testing.T) {
+		tree := NewTree("t", "id", "parent_id").Alias("node").Columns("name AS title", "lower(name) AS slug", `"Order"`)
+		tree.Root().AddExpression("parent_id IS NULL")
+		query, _, _ := tree.SQL()
+		t.Log(query)
+		if query != `WITH RECURSIVE tree AS (SELECT id, parent_id, name AS title, lower(name) AS slug, "Order", 0 AS depth, ARRAY[id] AS path FROM t WHERE (parent_id IS NULL) UNION ALL SELECT node.id, node.parent_id, node.name AS title, lower(name) AS slug, node."Order", tree.depth + 1, tree.path || node.id FROM t node JOIN tree ON node.parent_id = tree.id WHERE (NOT node.id = ANY(tree.path))) SELECT * FROM tree` {
+			t.Fatal("wrong alias_expressions")
+		}
+	})
+	t.Run("recursive_expression", func(t *testing.T) {
+		tree := NewTree("c", "id", "parent_id").Columns("name").Expression("lower(name) AS slug", "lower(t.name)")
+		tree.Root().AddExpression("parent_id IS NULL")
+		query, _, _ := tree.SQL()
+		t.Log(query)
+		if query != `WITH RECURSIVE tree AS (SELECT id, parent_id, name, lower(name) AS slug, 0 AS depth, ARRAY[id] AS path FROM c WHERE (parent_id IS NULL) UNION ALL SELECT t.id, t.parent_id, t.name, lower(t.name), tree.depth + 1, tree.path || t.id FROM c t JOIN tree ON t.parent_id = tree.id WHERE (NOT t.id = ANY(tree.path))) SELECT * FROM tree` {
+			t.Fatal("wrong recursive_expression")
+		}
+	})
+}
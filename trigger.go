@@ -0,0 +1,177 @@
+package gosql
+
+import "strings"
+
+// Trigger create trigger query builder
+// Arguments of when condition are inlined as literals
+// CREATE [ OR REPLACE ] TRIGGER name { BEFORE | AFTER | INSTEAD OF } { event [ OR ... ] }
+//
+//	ON table_name
+//	[ REFERENCING { { OLD | NEW } TABLE [ AS ] transition_relation_name } [ ... ] ]
+//	[ FOR [ EACH ] { ROW | STATEMENT } ]
+//	[ WHEN ( condition ) ]
+//	EXECUTE { FUNCTION | PROCEDURE } function_name ( arguments )
+//
+// where event can be one of:
+//
+// INSERT
+// UPDATE [ OF column_name [, ... ] ]
+// DELETE
+// TRUNCATE
+type Trigger struct {
+	// trigger name
+	name string
+	// or replace
+	orReplace bool
+	// BEFORE | AFTER | INSTEAD OF
+	timing string
+	// events
+	events expression
+	// table name
+	table string
+	// transition tables
+	referencing expression
+	// ROW | STATEMENT
+	forEach string
+	// when condition
+	when Condition
+	// function call
+	function string
+}
+
+// OrReplace replace existing trigger
+func (t *Trigger) OrReplace() *Trigger {
+	t.orReplace = true
+	return t
+}
+
+// Before fire before event
+func (t *Trigger) Before() *Trigger {
+	t.timing = "BEFORE"
+	return t
+}
+
+// After fire after event
+func (t *Trigger) After() *Trigger {
+	t.timing = "AFTER"
+	return t
+}
+
+// InsteadOf fire instead of event on view
+func (t *Trigger) InsteadOf() *Trigger {
+	t.timing = "INSTEAD OF"
+	return t
+}
+
+// OnInsert fire on insert
+func (t *Trigger) OnInsert() *Trigger {
+	t.events.Add("INSERT")
+	return t
+}
+
+// OnUpdate fire on update of any or listed columns
+func (t *Trigger) OnUpdate(columns ...string) *Trigger {
+	if len(columns) > 0 {
+		t.events.Add("UPDATE OF " + strings.Join(columns, ", "))
+	} else {
+		t.events.Add("UPDATE")
+	}
+	return t
+}
+
+// OnDelete fire on delete
+func (t *Trigger) OnDelete() *Trigger {
+	t.events.Add("DELETE")
+	return t
+}
+
+// OnTruncate fire on truncate
+func (t *Trigger) OnTruncate() *Trigger {
+	t.events.Add("TRUNCATE")
+	return t
+}
+
+// ReferencingOld name old transition table
+func (t *Trigger) ReferencingOld(name string) *Trigger {
+	t.referencing.Add("OLD TABLE AS " + name)
+	return t
+}
+
+// ReferencingNew name new transition table
+func (t *Trigger) ReferencingNew(name string) *Trigger {
+	t.referencing.Add("NEW TABLE AS " + name)
+	return t
+}
+
+// ForEachRow fire once for each row
+func (t *Trigger) ForEachRow() *Trigger {
+	t.forEach = "ROW"
+	return t
+}
+
+// ForEachStatement fire once for statement
+func (t *Trigger) ForEachStatement() *Trigger {
+	t.forEach = "STATEMENT"
+	return t
+}
+
+// When condition of firing. Example: OLD.* IS DISTINCT FROM NEW.*
+func (t *Trigger) When() *Condition {
+	return &t.when
+}
+
+// Execute set function and its string arguments
+func (t *Trigger) Execute(function string, args ...string) *Trigger {
+	t.function = function + "(" + strings.Join(args, ", ") + ")"
+	return t
+}
+
+// Validate check when condition arguments can be inlined
+func (t *Trigger) Validate() error {
+	_, err := inlineArguments(t.when.String(), t.when.GetArguments())
+	return err
+}
+
+// IsEmpty check if empty
+func (t *Trigger) IsEmpty() bool {
+	return t == nil || t.name == "" || t.table == "" || t.function == ""
+}
+
+// String render create trigger query. Panics if when condition arguments can not be inlined, use Validate to check
+func (t *Trigger) String() string {
+	if t.IsEmpty() {
+		return ""
+	}
+	var when string
+	if !t.when.IsEmpty() {
+		when = mustInlineArguments(t.when.String(), t.when.GetArguments())
+	}
+	b := strings.Builder{}
+	b.WriteString("CREATE")
+	if t.orReplace {
+		b.WriteString(" OR REPLACE")
+	}
+	b.WriteString(" TRIGGER " + t.name + " " + t.timing + " " + t.events.String(" OR ") + " ON " + t.table)
+	if t.referencing.Len() > 0 {
+		b.WriteString(" REFERENCING " + t.referencing.String(" "))
+	}
+	if t.forEach != "" {
+		b.WriteString(" FOR EACH " + t.forEach)
+	}
+	if when != "" {
+		b.WriteString(" WHEN " + when)
+	}
+	b.WriteString(" EXECUTE FUNCTION " + t.function)
+	return b.String() + ";"
+}
+
+// SQL common sql interface
+func (t *Trigger) SQL() (query string, params []any, returning []any) {
+	query = t.String()
+	return
+}
+
+// CreateTrigger create trigger constructor. Trigger fires after event by default
+func CreateTrigger(name string, table string) *Trigger {
+	return &Trigger{name: name, table: table, timing: "AFTER", when: Condition{operator: ConditionOperatorAnd}}
+}
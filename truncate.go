@@ -0,0 +1,88 @@
+package gosql
+
+import "strings"
+
+// Truncate query builder
+// TRUNCATE [ TABLE ] [ ONLY ] name [ * ] [, ... ]
+//
+//	[ RESTART IDENTITY | CONTINUE IDENTITY ] [ CASCADE | RESTRICT ]
+type Truncate struct {
+	// list of tables
+	tables expression
+	// RESTART IDENTITY | CONTINUE IDENTITY
+	identity string
+	// CASCADE | RESTRICT
+	option string
+}
+
+// Table add tables
+func (t *Truncate) Table(name ...string) *Truncate {
+	t.tables.Add(name...)
+	return t
+}
+
+// Only add tables without descendant tables
+func (t *Truncate) Only(name ...string) *Truncate {
+	for i := range name {
+		t.tables.Add("ONLY " + name[i])
+	}
+	return t
+}
+
+// RestartIdentity restart sequences owned by columns
+func (t *Truncate) RestartIdentity() *Truncate {
+	t.identity = "RESTART IDENTITY"
+	return t
+}
+
+// ContinueIdentity do not change sequences
+func (t *Truncate) ContinueIdentity() *Truncate {
+	t.identity = "CONTINUE IDENTITY"
+	return t
+}
+
+// Cascade truncate referencing tables
+func (t *Truncate) Cascade() *Truncate {
+	t.option = ActionCascade
+	return t
+}
+
+// Restrict refuse to truncate referenced tables
+func (t *Truncate) Restrict() *Truncate {
+	t.option = ActionRestrict
+	return t
+}
+
+// IsEmpty check if empty
+func (t *Truncate) IsEmpty() bool {
+	return t == nil || t.tables.Len() == 0
+}
+
+// String render truncate query
+func (t *Truncate) String() string {
+	if t.IsEmpty() {
+		return ""
+	}
+	b := strings.Builder{}
+	b.WriteString("TRUNCATE " + t.tables.String(", "))
+	if t.identity != "" {
+		b.WriteString(" " + t.identity)
+	}
+	if t.option != "" {
+		b.WriteString(" " + t.option)
+	}
+	return b.String() + ";"
+}
+
+// SQL common sql interface
+func (t *Truncate) SQL() (query string, params []any, returning []any) {
+	query = t.String()
+	return
+}
+
+// TruncateTable truncate constructor
+func TruncateTable(name ...string) *Truncate {
+	t := &Truncate{}
+	t.tables.Add(name...)
+	return t
+}
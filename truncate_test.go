@@ -0,0 +1,25 @@
+package gosql
+
+import "testing"
+
+func TestTruncate_String(t *testing.T) {
+	t.Run("simple", func(t *testing.T) {
+		tr := TruncateTable("bigtable", "fattable")
+		t.Log(tr.String())
+		if tr.String() != "TRUNCATE bigtable, fattable;" {
+			t.Fatal("wrong simple")
+		}
+	})
+	t.Run("restart_identity", func(t *testing.T) {
+		tr := TruncateTable("bigtable").Only("fattable").RestartIdentity().Cascade()
+		t.Log(tr.String())
+		if tr.String() != "TRUNCATE bigtable, ONLY fattable RESTART IDENTITY CASCADE;" {
+			t.Fatal("wrong restart_identity")
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		if TruncateTable().String() != "" {
+			t.Fatal("wrong empty")
+		}
+	})
+}
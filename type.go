@@ -0,0 +1,227 @@
+package gosql
+
+import "strings"
+
+// Type create type query builder
+// CREATE TYPE name AS ( [ attribute_name data_type [ COLLATE collation ] [, ... ] ] )
+//
+// CREATE TYPE name AS ENUM ( [ 'label' [, ... ] ] )
+//
+// CREATE TYPE name AS RANGE (
+//
+//	SUBTYPE = subtype
+//	[ , SUBTYPE_OPCLASS = subtype_operator_class ]
+//	[ , COLLATION = collation ]
+//	[ , CANONICAL = canonical_function ]
+//	[ , SUBTYPE_DIFF = subtype_diff_function ]
+//	[ , MULTIRANGE_TYPE_NAME = multirange_type_name ]
+//
+// )
+type Type struct {
+	// type name
+	name string
+	// enum labels
+	labels []string
+	// composite attributes
+	attributes columnDefinitions
+	// range options
+	rangeOptions *rangeType
+}
+
+// AsEnum enum type with labels
+func (t *Type) AsEnum(labels ...string) *Type {
+	t.labels = append(t.labels, labels...)
+	return t
+}
+
+// AddAttribute add attribute of composite type
+func (t *Type) AddAttribute(name string, dataType string) *column {
+	def, _ := t.attributes.Add()
+	return def.Column().Name(name).Type(dataType)
+}
+
+// Attributes get attributes of composite type
+func (t *Type) Attributes() *columnDefinitions {
+	return &t.attributes
+}
+
+// AsRange range type of subtype
+func (t *Type) AsRange(subtype string) *rangeType {
+	t.rangeOptions = &rangeType{subtype: subtype}
+	return t.rangeOptions
+}
+
+// IsEmpty check if empty
+func (t *Type) IsEmpty() bool {
+	return t == nil || t.name == ""
+}
+
+// String render create type query
+func (t *Type) String() string {
+	if t.IsEmpty() {
+		return ""
+	}
+	b := strings.Builder{}
+	b.WriteString("CREATE TYPE " + t.name)
+	if t.rangeOptions != nil {
+		b.WriteString(" AS RANGE (" + t.rangeOptions.String() + ")")
+	} else if len(t.labels) > 0 {
+		b.WriteString(" AS ENUM (")
+		for i := range t.labels {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(quoteLiteral(t.labels[i]))
+		}
+		b.WriteString(")")
+	} else {
+		b.WriteString(" AS (" + t.attributes.String() + ")")
+	}
+	return b.String() + ";"
+}
+
+// SQL common sql interface
+func (t *Type) SQL() (query string, params []any, returning []any) {
+	query = t.String()
+	return
+}
+
+// CreateType create type constructor
+func CreateType(name string) *Type {
+	return &Type{name: name}
+}
+
+// rangeType range type options
+type rangeType struct {
+	// subtype
+	subtype string
+	// other options
+	options expression
+}
+
+// SubtypeOpClass set subtype operator class
+func (r *rangeType) SubtypeOpClass(opClass string) *rangeType {
+	r.options.Add("SUBTYPE_OPCLASS = " + opClass)
+	return r
+}
+
+// Collation set collation
+func (r *rangeType) Collation(collation string) *rangeType {
+	r.options.Add("COLLATION = " + collation)
+	return r
+}
+
+// Canonical set canonical function
+func (r *rangeType) Canonical(function string) *rangeType {
+	r.options.Add("CANONICAL = " + function)
+	return r
+}
+
+// SubtypeDiff set subtype difference function
+func (r *rangeType) SubtypeDiff(function string) *rangeType {
+	r.options.Add("SUBTYPE_DIFF = " + function)
+	return r
+}
+
+// MultirangeTypeName set multirange type name
+func (r *rangeType) MultirangeTypeName(name string) *rangeType {
+	r.options.Add("MULTIRANGE_TYPE_NAME = " + name)
+	return r
+}
+
+// String render range options
+func (r *rangeType) String() string {
+	if r.options.Len() == 0 {
+		return "SUBTYPE = " + r.subtype
+	}
+	return "SUBTYPE = " + r.subtype + ", " + r.options.String(", ")
+}
+
+// TypeAlter alter type query builder
+// ALTER TYPE name ADD VALUE [ IF NOT EXISTS ] new_enum_value [ { BEFORE | AFTER } neighbor_enum_value ]
+//
+// ALTER TYPE name RENAME VALUE existing_enum_value TO new_enum_value
+//
+// ALTER TYPE name RENAME TO new_name
+//
+// ALTER TYPE name SET SCHEMA new_schema
+type TypeAlter struct {
+	// type name
+	name string
+	// action
+	action string
+	// neighbor position of new value
+	position string
+}
+
+// AddValue add enum value
+func (a *TypeAlter) AddValue(value string) *TypeAlter {
+	a.action = "ADD VALUE " + quoteLiteral(value)
+	return a
+}
+
+// AddValueIfNotExists add enum value if not exists
+func (a *TypeAlter) AddValueIfNotExists(value string) *TypeAlter {
+	a.action = "ADD VALUE IF NOT EXISTS " + quoteLiteral(value)
+	return a
+}
+
+// Before place new value before neighbor
+func (a *TypeAlter) Before(neighbor string) *TypeAlter {
+	a.position = "BEFORE " + quoteLiteral(neighbor)
+	return a
+}
+
+// After place new value after neighbor
+func (a *TypeAlter) After(neighbor string) *TypeAlter {
+	a.position = "AFTER " + quoteLiteral(neighbor)
+	return a
+}
+
+// RenameValue rename enum value
+func (a *TypeAlter) RenameValue(old, new string) *TypeAlter {
+	a.action = "RENAME VALUE " + quoteLiteral(old) + " TO " + quoteLiteral(new)
+	a.position = ""
+	return a
+}
+
+// Rename type
+func (a *TypeAlter) Rename(name string) *TypeAlter {
+	a.action = "RENAME TO " + name
+	a.position = ""
+	return a
+}
+
+// SetSchema move type to schema
+func (a *TypeAlter) SetSchema(schema string) *TypeAlter {
+	a.action = "SET SCHEMA " + schema
+	a.position = ""
+	return a
+}
+
+// IsEmpty check if empty
+func (a *TypeAlter) IsEmpty() bool {
+	return a == nil || a.name == "" || a.action == ""
+}
+
+// String render alter type query
+func (a *TypeAlter) String() string {
+	if a.IsEmpty() {
+		return ""
+	}
+	if a.position != "" {
+		return "ALTER TYPE " + a.name + " " + a.action + " " + a.position + ";"
+	}
+	return "ALTER TYPE " + a.name + " " + a.action + ";"
+}
+
+// SQL common sql interface
+func (a *TypeAlter) SQL() (query string, params []any, returning []any) {
+	query = a.String()
+	return
+}
+
+// AlterType alter type constructor
+func AlterType(name string) *TypeAlter {
+	return &TypeAlter{name: name}
+}
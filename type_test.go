@@ -0,0 +1,74 @@
+package gosql
+
+import "testing"
+
+func TestType_String(t *testing.T) {
+	t.Run("enum", func(t *testing.T) {
+		tp := CreateType("bug_status").AsEnum("new", "open", "won't fix")
+		t.Log(tp.String())
+		if tp.String() != "CREATE TYPE bug_status AS ENUM ('new', 'open', 'won''t fix');" {
+			t.Fatal("wrong enum")
+		}
+	})
+	t.Run("composite", func(t *testing.T) {
+		tp := CreateType("compfoo")
+		tp.AddAttribute("f1", "int")
+		tp.AddAttribute("f2", "text").Collate("\"C\"")
+		t.Log(tp.String())
+		if tp.String() != "CREATE TYPE compfoo AS (f1 int, f2 text COLLATE \"C\");" {
+			t.Fatal("wrong composite")
+		}
+	})
+	t.Run("range", func(t *testing.T) {
+		tp := CreateType("float8_range")
+		tp.AsRange("float8").SubtypeDiff("float8mi")
+		t.Log(tp.String())
+		if tp.String() != "CREATE TYPE float8_range AS RANGE (SUBTYPE = float8, SUBTYPE_DIFF = float8mi);" {
+			t.Fatal("wrong range")
+		}
+	})
+}
+
+func TestTypeAlter_String(t *testing.T) {
+	t.Run("add_value", func(t *testing.T) {
+		a := AlterType("colors").AddValueIfNotExists("orange").After("red")
+		t.Log(a.String())
+		if a.String() != "ALTER TYPE colors ADD VALUE IF NOT EXISTS 'orange' AFTER 'red';" {
+			t.Fatal("wrong add_value")
+		}
+	})
+	t.Run("add_value_before", func(t *testing.T) {
+		a := AlterType("colors").AddValue("purple").Before("blue")
+		t.Log(a.String())
+		if a.String() != "ALTER TYPE colors ADD VALUE 'purple' BEFORE 'blue';" {
+			t.Fatal("wrong add_value_before")
+		}
+	})
+	t.Run("rename_value", func(t *testing.T) {
+		a := AlterType("colors").RenameValue("purple", "mauve")
+		t.Log(a.String())
+		if a.String() != "ALTER TYPE colors RENAME VALUE 'purple' TO 'mauve';" {
+			t.Fatal("wrong rename_value")
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		if AlterType("colors").String() != "" {
+			t.Fatal("wrong empty")
+		}
+	})
+}
+
+func TestDomain_String(t *testing.T) {
+	d := CreateDomain("us_postal_code", "text").NotNull().
+		Check("", `VALUE ~ '^\d{5}$'`).
+		Check("long_code", `VALUE ~ '^\d{5}-\d{4}$'`)
+	t.Log(d.String())
+	if d.String() != `CREATE DOMAIN us_postal_code AS text NOT NULL CHECK (VALUE ~ '^\d{5}$') CONSTRAINT long_code CHECK (VALUE ~ '^\d{5}-\d{4}$');` {
+		t.Fatal("wrong domain")
+	}
+	hex := CreateDomain("color", "text").Check("hex", "VALUE ~ '^#[0-9a-f]{6}$'")
+	t.Log(hex.String())
+	if hex.String() != "CREATE DOMAIN color AS text CONSTRAINT hex CHECK (VALUE ~ '^#[0-9a-f]{6}$');" {
+		t.Fatal("wrong domain check with #")
+	}
+}
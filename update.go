@@ -3,17 +3,38 @@ package gosql
 import "strings"
 
 // Update query builder
+// [ WITH [ RECURSIVE ] with_query [, ...] ]
+// UPDATE [ ONLY ] table_name [ * ] [ [ AS ] alias ]
+//
+//	SET { column_name = { expression | DEFAULT } | ... } [, ...]
+//	[ FROM from_item [, ...] ]
+//	[ WHERE condition | WHERE CURRENT OF cursor_name ]
+//	[ RETURNING { * | output_expression [ [ AS ] output_name ] } [, ...] ]
 type Update struct {
 	// with query
 	with with
 	// table name
 	table string
+	// only table without descendant tables
+	only bool
+	// table alias
+	alias string
 	// set of changes
 	set expression
 	// from source
-	from []string
+	from expression
 	// condition
 	where Condition
+	// cursor name for WHERE CURRENT OF
+	cursor string
+	// version condition
+	version Condition
+	// version increment
+	versionSet string
+	// version column returned into destination
+	versionColumn string
+	// version destination
+	versionDest any
 	// returning
 	returning expression
 }
@@ -22,21 +43,29 @@ type Update struct {
 func (u *Update) IsEmpty() bool {
 	return u == nil || (u.with.Len() == 0 &&
 		u.table == "" &&
-		len(u.from) == 0 &&
+		u.from.Len() == 0 &&
 		u.where.IsEmpty() &&
+		u.version.IsEmpty() &&
+		u.cursor == "" &&
 		u.set.Len() == 0 &&
 		u.returning.Len() == 0)
 }
 
 // From update
 func (u *Update) From(from ...string) *Update {
-	u.from = append(u.from, from...)
+	u.from.Add(from...)
+	return u
+}
+
+// FromExpression from item with arguments. Example: (VALUES (?, ?)) AS v(id, name)
+func (u *Update) FromExpression(from string, args ...any) *Update {
+	u.from.Append(from, args...)
 	return u
 }
 
 // ResetFrom clear from
 func (u *Update) ResetFrom() *Update {
-	u.from = u.from[:0]
+	u.from.Reset()
 	return u
 }
 
@@ -45,6 +74,52 @@ func (u *Update) Where() *Condition {
 	return &u.where
 }
 
+// CurrentOf update row of cursor. Where condition is ignored
+func (u *Update) CurrentOf(cursor string) *Update {
+	u.cursor = cursor
+	return u
+}
+
+// WithVersion optimistic lock by version column
+// Adds version condition, increments version and returns new version into dest if it is not nil
+// Repeated call replaces previous version condition, increment and destination
+func (u *Update) WithVersion(column string, expected any, dest any) *Update {
+	name := column[strings.LastIndex(column, ".")+1:]
+	u.version = Condition{operator: ConditionOperatorAnd}
+	u.version.AddExpression(column+" = ?", expected)
+	u.versionSet = name + " = " + name + " + 1"
+	u.versionColumn = column
+	u.versionDest = dest
+	return u
+}
+
+// condition where condition combined with version condition
+func (u *Update) condition() Condition {
+	return andCondition(&u.where, &u.version)
+}
+
+// setExpression set of changes with version increment
+func (u *Update) setExpression() *expression {
+	if u.versionSet == "" {
+		return &u.set
+	}
+	set := &expression{}
+	set.copyFrom(&u.set)
+	set.Add(u.versionSet)
+	return set
+}
+
+// returningExpression returning with version destination
+func (u *Update) returningExpression() *expression {
+	if u.versionDest == nil {
+		return &u.returning
+	}
+	returning := &expression{}
+	returning.copyFrom(&u.returning)
+	returning.Append(u.versionColumn, u.versionDest)
+	return returning
+}
+
 // String return result query
 func (u *Update) String() string {
 	if u.IsEmpty() {
@@ -55,19 +130,28 @@ func (u *Update) String() string {
 		b.WriteString(u.with.String() + " ")
 	}
 	if u.table != "" {
-		b.WriteString("UPDATE " + u.table)
+		b.WriteString("UPDATE ")
+		if u.only {
+			b.WriteString("ONLY ")
+		}
+		b.WriteString(u.table)
+		if u.alias != "" {
+			b.WriteString(" AS " + u.alias)
+		}
 	}
-	if u.set.Len() > 0 {
-		b.WriteString(" SET " + u.set.String(", "))
+	if set := u.setExpression(); set.Len() > 0 {
+		b.WriteString(" SET " + set.String(", "))
 	}
-	if len(u.from) != 0 {
-		b.WriteString(" FROM " + strings.Join(u.from, ", "))
+	if u.from.Len() > 0 {
+		b.WriteString(" FROM " + u.from.String(", "))
 	}
-	if !u.where.IsEmpty() {
-		b.WriteString(" WHERE " + u.where.String())
+	if u.cursor != "" {
+		b.WriteString(" WHERE CURRENT OF " + u.cursor)
+	} else if where := u.condition(); !where.IsEmpty() {
+		b.WriteString(" WHERE " + where.String())
 	}
-	if u.returning.Len() > 0 {
-		b.WriteString(" RETURNING " + u.returning.String(", "))
+	if returning := u.returningExpression(); returning.Len() > 0 {
+		b.WriteString(" RETURNING " + returning.String(", "))
 	}
 	b.WriteString(";")
 	return b.String()
@@ -84,6 +168,18 @@ func (u *Update) Table(table string) *Update {
 	return u
 }
 
+// Only update table without descendant tables
+func (u *Update) Only() *Update {
+	u.only = true
+	return u
+}
+
+// Alias set table alias
+func (u *Update) Alias(alias string) *Update {
+	u.alias = alias
+	return u
+}
+
 // ResetTable reset table
 func (u *Update) ResetTable() *Update {
 	u.table = ""
@@ -92,7 +188,12 @@ func (u *Update) ResetTable() *Update {
 
 // GetArguments get all values
 func (u *Update) GetArguments() []any {
-	return append(append(u.with.GetArguments(), u.set.GetArguments()...), u.where.GetArguments()...)
+	arguments := append(append(u.with.GetArguments(), u.set.GetArguments()...), u.from.GetArguments()...)
+	if u.cursor == "" {
+		where := u.condition()
+		arguments = append(arguments, where.GetArguments()...)
+	}
+	return arguments
 }
 
 // Returning get returning expression
@@ -107,7 +208,7 @@ func (u *Update) With() *with {
 
 // SQL Get sql query
 func (u *Update) SQL() (query string, params []any, returning []any) {
-	return u.String(), append(append(u.with.GetArguments(), u.set.GetArguments()...), u.where.GetArguments()...), u.returning.GetArguments()
+	return u.String(), u.GetArguments(), u.returningExpression().GetArguments()
 }
 
 // NewUpdate Update Query Builder
@@ -0,0 +1,194 @@
+package gosql
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrBulkUpdateArguments row arguments count is not equal to count of key and set columns
+var ErrBulkUpdateArguments = errors.New("gosql: bulk update row arguments count does not match columns count")
+
+// ErrBulkUpdateParamsLimit one row of bulk update does not fit in params limit
+var ErrBulkUpdateParamsLimit = errors.New("gosql: bulk update row does not fit in params limit")
+
+// BulkUpdate update many rows with different values in one query
+// UPDATE table_name SET column = v.column [, ...]
+//
+//	FROM ( VALUES ( ?::type, ... ) [, ...] ) AS v( key_column, ..., column, ... )
+//	WHERE table_name.key_column = v.key_column [AND ...]
+//	[ RETURNING output_expression ]
+type BulkUpdate struct {
+	// target table
+	table string
+	// values alias
+	alias string
+	// key columns
+	keys []string
+	// updated columns
+	columns []string
+	// column types
+	types map[string]string
+	// rows of key and column values
+	rows [][]any
+	// extra condition
+	where Condition
+	// returning
+	returning expression
+}
+
+// Key set key columns for matching rows
+func (b *BulkUpdate) Key(columns ...string) *BulkUpdate {
+	b.keys = append(b.keys, columns...)
+	return b
+}
+
+// Columns set updated columns
+func (b *BulkUpdate) Columns(columns ...string) *BulkUpdate {
+	b.columns = append(b.columns, columns...)
+	return b
+}
+
+// Types set column types for values cast. Example: map[string]string{"id": "int"}
+func (b *BulkUpdate) Types(types map[string]string) *BulkUpdate {
+	b.types = types
+	return b
+}
+
+// Alias set values alias. Default is v
+func (b *BulkUpdate) Alias(alias string) *BulkUpdate {
+	b.alias = alias
+	return b
+}
+
+// Row add row. Key values go first, then updated columns values
+func (b *BulkUpdate) Row(args ...any) *BulkUpdate {
+	b.rows = append(b.rows, args)
+	return b
+}
+
+// Len count of rows
+func (b *BulkUpdate) Len() int {
+	return len(b.rows)
+}
+
+// Where extra condition
+func (b *BulkUpdate) Where() *Condition {
+	return &b.where
+}
+
+// Returning get returning expression
+func (b *BulkUpdate) Returning() *expression {
+	return &b.returning
+}
+
+// Validate check rows arguments
+func (b *BulkUpdate) Validate() error {
+	for _, row := range b.rows {
+		if len(row) != len(b.keys)+len(b.columns) {
+			return ErrBulkUpdateArguments
+		}
+	}
+	return nil
+}
+
+// valuesAlias get alias of values
+func (b *BulkUpdate) valuesAlias() string {
+	if b.alias == "" {
+		return "v"
+	}
+	return b.alias
+}
+
+// update build update query for rows
+func (b *BulkUpdate) update(rows [][]any) *Update {
+	alias := b.valuesAlias()
+	target := tableReference(b.table)
+	columns := append(append(make([]string, 0, len(b.keys)+len(b.columns)), b.keys...), b.columns...)
+	u := NewUpdate().Table(b.table)
+	for _, column := range b.columns {
+		u.Set().Add(column + " = " + alias + "." + column)
+	}
+	values := strings.Builder{}
+	values.WriteString("(VALUES ")
+	var args []any
+	for i, row := range rows {
+		if i > 0 {
+			values.WriteString(", ")
+		}
+		values.WriteString("(")
+		for j, arg := range row {
+			if j > 0 {
+				values.WriteString(", ")
+			}
+			cell, params := valueCell(arg)
+			values.WriteString(cell)
+			if j < len(columns) {
+				if t, ok := b.types[columns[j]]; ok {
+					values.WriteString("::" + t)
+				}
+			}
+			args = append(args, params...)
+		}
+		values.WriteString(")")
+	}
+	values.WriteString(") AS " + alias + "(" + strings.Join(columns, ", ") + ")")
+	u.FromExpression(values.String(), args...)
+	for _, key := range b.keys {
+		u.Where().AddExpression(target + "." + key + " = " + alias + "." + key)
+	}
+	if !b.where.IsEmpty() {
+		u.Where().AddExpression(b.where.String(), b.where.GetArguments()...)
+	}
+	u.Returning().copyFrom(&b.returning)
+	return u
+}
+
+// Update build update query for all rows
+// Query is empty if there are no rows or rows are not valid, use Validate to check
+func (b *BulkUpdate) Update() *Update {
+	if len(b.rows) == 0 || b.Validate() != nil {
+		return NewUpdate()
+	}
+	return b.update(b.rows)
+}
+
+// Batches split rows into update queries with number of params not greater than maxParams
+// If maxParams <= 0 PostgresMaxParams is used
+func (b *BulkUpdate) Batches(maxParams int) ([]*Update, error) {
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	if maxParams <= 0 {
+		maxParams = PostgresMaxParams
+	}
+	limit := maxParams - len(b.where.GetArguments())
+	batches := make([]*Update, 0, 2)
+	for start := 0; start < len(b.rows); {
+		var params int
+		end := start
+		for end < len(b.rows) {
+			rowParams := len(valueArguments(b.rows[end]))
+			if params+rowParams > limit {
+				break
+			}
+			params += rowParams
+			end++
+		}
+		if end == start {
+			return nil, ErrBulkUpdateParamsLimit
+		}
+		batches = append(batches, b.update(b.rows[start:end]))
+		start = end
+	}
+	return batches, nil
+}
+
+// SQL common sql interface
+func (b *BulkUpdate) SQL() (query string, params []any, returning []any) {
+	return b.Update().SQL()
+}
+
+// NewBulkUpdate bulk update constructor
+func NewBulkUpdate(table string) *BulkUpdate {
+	return &BulkUpdate{table: table, where: Condition{operator: ConditionOperatorAnd}}
+}
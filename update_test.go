@@ -122,4 +122,88 @@ func TestUpdate_String(t *testing.T) {
 			t.Fatal("wrong agg")
 		}
 	})
+
+	t.Run("only_alias", func(t *testing.T) {
+		u := NewUpdate().Table("films").Only().Alias("f")
+		u.Set().Append("kind = ?", "Dramatic")
+		u.Where().AddExpression("f.kind = ?", "Drama")
+		t.Log(u.String())
+		if u.String() != "UPDATE ONLY films AS f SET kind = ? WHERE (f.kind = ?);" || len(u.GetArguments()) != 2 {
+			t.Fatal("wrong only_alias")
+		}
+	})
+
+	t.Run("current_of", func(t *testing.T) {
+		u := NewUpdate().Table("films").CurrentOf("c_films")
+		u.Set().Append("kind = ?", "Dramatic")
+		t.Log(u.String())
+		if u.String() != "UPDATE films SET kind = ? WHERE CURRENT OF c_films;" || len(u.GetArguments()) != 1 {
+			t.Fatal("wrong current_of")
+		}
+	})
+}
+
+func TestBulkUpdate(t *testing.T) {
+	t.Run("simple", func(t *testing.T) {
+		b := NewBulkUpdate("films AS f").
+			Key("id").
+			Columns("title", "len").
+			Types(map[string]string{"id": "int", "len": "int"}).
+			Row(1, "Yojimbo", 110).
+			Row(2, "Tampopo", nil)
+		b.Where().AddExpression("f.locked IS NOT TRUE")
+		b.Returning().Add("f.id")
+		query, params, _ := b.SQL()
+		t.Log(query)
+		if query != "UPDATE films AS f SET title = v.title, len = v.len FROM (VALUES (?::int, ?, ?::int), (?::int, ?, ?::int)) AS v(id, title, len) WHERE (f.id = v.id AND (f.locked IS NOT TRUE)) RETURNING f.id;" {
+			t.Fatal("wrong bulk update")
+		}
+		if len(params) != 6 || params[3] != 2 {
+			t.Fatal("wrong bulk update params")
+		}
+	})
+	t.Run("batches", func(t *testing.T) {
+		b := NewBulkUpdate("films").Key("id").Columns("title")
+		for i := 0; i < 5; i++ {
+			b.Row(i, "title")
+		}
+		b.Where().AddExpression("kind = ?", "Drama")
+		batches, err := b.Batches(5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(batches) != 3 {
+			t.Fatal("wrong batches count")
+		}
+		t.Log(batches[2].String())
+		if batches[2].String() != "UPDATE films SET title = v.title FROM (VALUES (?, ?)) AS v(id, title) WHERE (films.id = v.id AND (kind = ?));" {
+			t.Fatal("wrong last batch")
+		}
+		if args := batches[0].GetArguments(); len(args) != 5 || args[4] != "Drama" {
+			t.Fatal("wrong batch arguments")
+		}
+	})
+	t.Run("invalid", func(t *testing.T) {
+		b := NewBulkUpdate("films").Key("id").Columns("title").Row(1)
+		if _, err := b.Batches(0); err != ErrBulkUpdateArguments {
+			t.Fatal("must be ErrBulkUpdateArguments")
+		}
+		if query, params, _ := b.SQL(); query != "" || len(params) != 0 {
+			t.Fatal("invalid rows must render empty query")
+		}
+	})
+	t.Run("no_rows", func(t *testing.T) {
+		b := NewBulkUpdate("films").Key("id").Columns("title")
+		if query, _, _ := b.SQL(); query != "" {
+			t.Fatal("no rows must render empty query")
+		}
+	})
+	t.Run("from_expression", func(t *testing.T) {
+		u := NewUpdate().Table("films").FromExpression("(SELECT id FROM archive WHERE kind = ?) a", "Drama")
+		u.Set().Append("title = ?", "x")
+		u.Where().AddExpression("films.id = a.id AND films.len > ?", 10)
+		if args := u.GetArguments(); len(args) != 3 || args[0] != "x" || args[1] != "Drama" || args[2] != 10 {
+			t.Fatal("wrong from expression arguments order")
+		}
+	})
 }
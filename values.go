@@ -0,0 +1,172 @@
+package gosql
+
+import "strings"
+
+// DefaultValue DEFAULT keyword as row value
+var DefaultValue = Raw("DEFAULT")
+
+// RawValue sql expression used as row value instead of placeholder
+type RawValue struct {
+	// sql expression
+	expression string
+	// expression arguments
+	args []any
+}
+
+// String get expression
+func (r RawValue) String() string {
+	return r.expression
+}
+
+// GetArguments get expression arguments
+func (r RawValue) GetArguments() []any {
+	return r.args
+}
+
+// IsValid check if count of placeholders equal to count of arguments
+func (r RawValue) IsValid() bool {
+	return countPlaceholders(r.expression) == len(r.args)
+}
+
+// countPlaceholders count ? placeholders outside of string literals and quoted identifiers
+// Jsonb operators ?| and ?& and ? followed by string literal are not counted. Example: data ? 'key'
+func countPlaceholders(expression string) int {
+	return len(placeholders(expression))
+}
+
+// placeholders positions of ? placeholders outside of string literals and quoted identifiers
+func placeholders(expression string) []int {
+	var positions []int
+	var quote byte
+	for i := 0; i < len(expression); i++ {
+		c := expression[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '?':
+			if isJsonbOperator(expression[i+1:]) {
+				continue
+			}
+			positions = append(positions, i)
+		}
+	}
+	return positions
+}
+
+// isJsonbOperator check if text after ? continues jsonb key exists operator
+func isJsonbOperator(next string) bool {
+	if next != "" && (next[0] == '|' || next[0] == '&') {
+		return true
+	}
+	next = strings.TrimLeft(next, " \t\n")
+	return next != "" && next[0] == '\''
+}
+
+// Raw sql expression as row value. Example: Raw("now()"), Raw("nextval(?)", "seq")
+func Raw(expression string, args ...any) RawValue {
+	return RawValue{expression: expression, args: args}
+}
+
+// valueCell render row value. Placeholder for argument or raw expression
+func valueCell(arg any) (string, []any) {
+	if raw, ok := arg.(RawValue); ok {
+		return raw.expression, raw.args
+	}
+	return "?", []any{arg}
+}
+
+// valueArguments get arguments of row values
+func valueArguments(args []any) []any {
+	params := make([]any, 0, len(args))
+	for _, arg := range args {
+		if raw, ok := arg.(RawValue); ok {
+			params = append(params, raw.args...)
+		} else {
+			params = append(params, arg)
+		}
+	}
+	return params
+}
+
+// Values list builder
+// VALUES ( expression [, ...] ) [, ...]
+type Values struct {
+	// rows of values
+	rows []*expression
+}
+
+// Row add row of expressions
+func (v *Values) Row() *expression {
+	row := &expression{}
+	v.rows = append(v.rows, row)
+	return row
+}
+
+// Arg add row of placeholders for each argument
+// RawValue arguments are rendered as expressions
+func (v *Values) Arg(args ...any) *Values {
+	row := v.Row()
+	for _, arg := range args {
+		cell, params := valueCell(arg)
+		row.Append(cell, params...)
+	}
+	return v
+}
+
+// Len count of rows
+func (v *Values) Len() int {
+	if v == nil {
+		return 0
+	}
+	return len(v.rows)
+}
+
+// Reset remove all rows
+func (v *Values) Reset() *Values {
+	v.rows = v.rows[:0]
+	return v
+}
+
+// IsEmpty check if empty
+func (v *Values) IsEmpty() bool {
+	return v.Len() == 0
+}
+
+// String render values
+func (v *Values) String() string {
+	if v.IsEmpty() {
+		return ""
+	}
+	b := strings.Builder{}
+	b.WriteString("VALUES ")
+	for i, row := range v.rows {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString("(" + row.String(", ") + ")")
+	}
+	return b.String()
+}
+
+// GetArguments get all arguments
+func (v *Values) GetArguments() []any {
+	var params []any
+	for _, row := range v.rows {
+		params = append(params, row.GetArguments()...)
+	}
+	return params
+}
+
+// SQL common sql interface
+func (v *Values) SQL() (query string, params []any, returning []any) {
+	return v.String() + ";", v.GetArguments(), nil
+}
+
+// NewValues values constructor
+func NewValues() *Values {
+	return &Values{}
+}
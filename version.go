@@ -0,0 +1,33 @@
+package gosql
+
+import (
+	"database/sql"
+	"errors"
+)
+
+// ErrStaleVersion row was changed or removed by another transaction
+var ErrStaleVersion = errors.New("gosql: stale version of row")
+
+// CheckVersion check result of query with version condition
+// Zero affected rows is ErrStaleVersion. No rows error of scan is ErrStaleVersion as well
+// Example: err := gosql.CheckVersion(db.Exec(query, params...))
+// Example: err := gosql.CheckVersion(nil, db.QueryRow(query, params...).Scan(returning...))
+func CheckVersion(result sql.Result, err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrStaleVersion
+	}
+	if err != nil {
+		return err
+	}
+	if result == nil {
+		return nil
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrStaleVersion
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package gosql
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+)
+
+type versionResult int64
+
+func (r versionResult) LastInsertId() (int64, error) { return 0, nil }
+func (r versionResult) RowsAffected() (int64, error) { return int64(r), nil }
+
+func TestWithVersion(t *testing.T) {
+	t.Run("update", func(t *testing.T) {
+		u := NewUpdate().Table("accounts a")
+		u.Set().Append("balance = ?", 100)
+		u.Where().AddExpression("a.id = ?", 1)
+		var id, version int
+		u.Returning().Append("a.id", &id)
+		u.WithVersion("a.version", 3, &version)
+		query, params, returning := u.SQL()
+		t.Log(query)
+		if query != "UPDATE accounts a SET balance = ?, version = version + 1 WHERE (a.id = ? AND a.version = ?) RETURNING a.id, a.version;" {
+			t.Fatal("wrong update with version")
+		}
+		if len(params) != 3 || params[2] != 3 {
+			t.Fatal("wrong update with version params")
+		}
+		if len(returning) != 2 || returning[0] != &id || returning[1] != &version {
+			t.Fatal("wrong update with version returning")
+		}
+	})
+	t.Run("update_or_where", func(t *testing.T) {
+		u := NewUpdate().Table("accounts")
+		u.Set().Append("balance = ?", 100)
+		u.Where().Merge(ConditionOperatorOr,
+			NewSqlCondition(ConditionOperatorAnd).AddExpression("id = ?", 1),
+			NewSqlCondition(ConditionOperatorAnd).AddExpression("id = ?", 2))
+		u.WithVersion("version", 3, nil)
+		query, params, returning := u.SQL()
+		t.Log(query)
+		if query != "UPDATE accounts SET balance = ?, version = version + 1 WHERE (((id = ?) OR (id = ?)) AND (version = ?));" {
+			t.Fatal("wrong update or where with version")
+		}
+		if len(params) != 4 || params[3] != 3 || len(returning) != 0 {
+			t.Fatal("wrong update or where with version params")
+		}
+	})
+	t.Run("update_twice", func(t *testing.T) {
+		u := NewUpdate().Table("accounts")
+		u.Set().Append("balance = ?", 100)
+		var first, second int
+		u.WithVersion("version", 3, &first)
+		u.WithVersion("version", 4, &second)
+		query, params, returning := u.SQL()
+		t.Log(query)
+		if query != "UPDATE accounts SET balance = ?, version = version + 1 WHERE (version = ?) RETURNING version;" {
+			t.Fatal("wrong update with version twice")
+		}
+		if len(params) != 2 || params[1] != 4 || len(returning) != 1 || returning[0] != &second {
+			t.Fatal("wrong update with version twice params")
+		}
+	})
+	t.Run("delete", func(t *testing.T) {
+		d := NewDelete().From("accounts").WithVersion("version", 3)
+		d.Where().AddExpression("id = ?", 1)
+		t.Log(d.String())
+		if d.String() != "DELETE FROM accounts WHERE (id = ? AND version = ?);" || d.GetArguments()[1] != 3 {
+			t.Fatal("wrong delete with version")
+		}
+	})
+	t.Run("check", func(t *testing.T) {
+		if CheckVersion(versionResult(1), nil) != nil {
+			t.Fatal("must be nil")
+		}
+		if CheckVersion(versionResult(0), nil) != ErrStaleVersion {
+			t.Fatal("must be ErrStaleVersion")
+		}
+		if CheckVersion(nil, sql.ErrNoRows) != ErrStaleVersion {
+			t.Fatal("must be ErrStaleVersion on no rows")
+		}
+		if CheckVersion(nil, nil) != nil {
+			t.Fatal("must be nil on scanned row")
+		}
+		errDriver := errors.New("driver")
+		if CheckVersion(nil, errDriver) != errDriver {
+			t.Fatal("must be driver error")
+		}
+	})
+}
@@ -0,0 +1,319 @@
+package gosql
+
+import "strings"
+
+const (
+	// ViewCheckLocal check conditions of the view only
+	ViewCheckLocal = "LOCAL"
+	// ViewCheckCascaded check conditions of the view and underlying views
+	ViewCheckCascaded = "CASCADED"
+)
+
+// View create view query builder
+// Query arguments are inlined as literals
+// CREATE [ OR REPLACE ] [ TEMP | TEMPORARY ] [ RECURSIVE ] VIEW name [ ( column_name [, ...] ) ]
+//
+//	[ WITH ( view_option_name [= view_option_value] [, ... ] ) ]
+//	AS query
+//	[ WITH [ CASCADED | LOCAL ] CHECK OPTION ]
+type View struct {
+	// view name
+	name string
+	// or replace
+	orReplace bool
+	// temporary
+	temp bool
+	// recursive
+	recursive bool
+	// column names
+	columns expression
+	// view options
+	with expression
+	// query
+	query *Select
+	// check option
+	checkOption string
+}
+
+// OrReplace replace existing view
+func (v *View) OrReplace() *View {
+	v.orReplace = true
+	return v
+}
+
+// Temp temporary view
+func (v *View) Temp() *View {
+	v.temp = true
+	return v
+}
+
+// Recursive recursive view. Columns are required
+func (v *View) Recursive() *View {
+	v.recursive = true
+	return v
+}
+
+// Columns add column names
+func (v *View) Columns(columns ...string) *View {
+	v.columns.Add(columns...)
+	return v
+}
+
+// With add view options. Example: security_barrier = true
+func (v *View) With(options ...string) *View {
+	v.with.Add(options...)
+	return v
+}
+
+// CheckOption check new rows satisfy view condition. Level is ViewCheckLocal, ViewCheckCascaded or empty
+func (v *View) CheckOption(level string) *View {
+	v.checkOption = strings.TrimSpace(level + " CHECK OPTION")
+	return v
+}
+
+// Validate check query arguments can be inlined
+func (v *View) Validate() error {
+	_, err := viewQuery(v.query)
+	return err
+}
+
+// IsEmpty check if empty
+func (v *View) IsEmpty() bool {
+	return v == nil || v.name == "" || v.query == nil
+}
+
+// String render create view query. Panics if query arguments can not be inlined, use Validate to check
+func (v *View) String() string {
+	if v.IsEmpty() {
+		return ""
+	}
+	query := mustInlineArguments(v.query.String(), v.query.GetArguments())
+	b := strings.Builder{}
+	b.WriteString("CREATE")
+	if v.orReplace {
+		b.WriteString(" OR REPLACE")
+	}
+	if v.temp {
+		b.WriteString(" TEMPORARY")
+	}
+	if v.recursive {
+		b.WriteString(" RECURSIVE")
+	}
+	b.WriteString(" VIEW " + v.name)
+	if v.columns.Len() > 0 {
+		b.WriteString(" (" + v.columns.String(", ") + ")")
+	}
+	if v.with.Len() > 0 {
+		b.WriteString(" WITH (" + v.with.String(", ") + ")")
+	}
+	b.WriteString(" AS " + query)
+	if v.checkOption != "" {
+		b.WriteString(" WITH " + v.checkOption)
+	}
+	return b.String() + ";"
+}
+
+// SQL common sql interface
+func (v *View) SQL() (query string, params []any, returning []any) {
+	query = v.String()
+	return
+}
+
+// CreateView create view constructor
+func CreateView(name string, query *Select) *View {
+	return &View{name: name, query: query}
+}
+
+// MaterializedView create materialized view query builder
+// Query arguments are inlined as literals
+// CREATE MATERIALIZED VIEW [ IF NOT EXISTS ] table_name
+//
+//	[ (column_name [, ...] ) ]
+//	[ USING method ]
+//	[ WITH ( storage_parameter [= value] [, ... ] ) ]
+//	[ TABLESPACE tablespace_name ]
+//	AS query
+//	[ WITH [ NO ] DATA ]
+type MaterializedView struct {
+	// view name
+	name string
+	// if not exists
+	ifNotExists bool
+	// column names
+	columns expression
+	// access method
+	using string
+	// storage parameters
+	with expression
+	// tablespace
+	tablespace string
+	// query
+	query *Select
+	// WITH DATA | WITH NO DATA
+	data string
+}
+
+// IfNotExists do not throw an error if view exists
+func (m *MaterializedView) IfNotExists() *MaterializedView {
+	m.ifNotExists = true
+	return m
+}
+
+// Columns add column names
+func (m *MaterializedView) Columns(columns ...string) *MaterializedView {
+	m.columns.Add(columns...)
+	return m
+}
+
+// Using set access method
+func (m *MaterializedView) Using(method string) *MaterializedView {
+	m.using = method
+	return m
+}
+
+// With add storage parameters. Example: fillfactor = 70
+func (m *MaterializedView) With(options ...string) *MaterializedView {
+	m.with.Add(options...)
+	return m
+}
+
+// TableSpace set tablespace
+func (m *MaterializedView) TableSpace(name string) *MaterializedView {
+	m.tablespace = name
+	return m
+}
+
+// WithData populate view on create
+func (m *MaterializedView) WithData() *MaterializedView {
+	m.data = "WITH DATA"
+	return m
+}
+
+// WithNoData do not populate view on create
+func (m *MaterializedView) WithNoData() *MaterializedView {
+	m.data = "WITH NO DATA"
+	return m
+}
+
+// Validate check query arguments can be inlined
+func (m *MaterializedView) Validate() error {
+	_, err := viewQuery(m.query)
+	return err
+}
+
+// IsEmpty check if empty
+func (m *MaterializedView) IsEmpty() bool {
+	return m == nil || m.name == "" || m.query == nil
+}
+
+// String render create materialized view query. Panics if query arguments can not be inlined, use Validate to check
+func (m *MaterializedView) String() string {
+	if m.IsEmpty() {
+		return ""
+	}
+	query := mustInlineArguments(m.query.String(), m.query.GetArguments())
+	b := strings.Builder{}
+	b.WriteString("CREATE MATERIALIZED VIEW")
+	if m.ifNotExists {
+		b.WriteString(" IF NOT EXISTS")
+	}
+	b.WriteString(" " + m.name)
+	if m.columns.Len() > 0 {
+		b.WriteString(" (" + m.columns.String(", ") + ")")
+	}
+	if m.using != "" {
+		b.WriteString(" USING " + m.using)
+	}
+	if m.with.Len() > 0 {
+		b.WriteString(" WITH (" + m.with.String(", ") + ")")
+	}
+	if m.tablespace != "" {
+		b.WriteString(" TABLESPACE " + m.tablespace)
+	}
+	b.WriteString(" AS " + query)
+	if m.data != "" {
+		b.WriteString(" " + m.data)
+	}
+	return b.String() + ";"
+}
+
+// SQL common sql interface
+func (m *MaterializedView) SQL() (query string, params []any, returning []any) {
+	query = m.String()
+	return
+}
+
+// CreateMaterializedView create materialized view constructor
+func CreateMaterializedView(name string, query *Select) *MaterializedView {
+	return &MaterializedView{name: name, query: query}
+}
+
+// Refresh refresh materialized view query builder
+// REFRESH MATERIALIZED VIEW [ CONCURRENTLY ] name [ WITH [ NO ] DATA ]
+type Refresh struct {
+	// view name
+	name string
+	// concurrently
+	concurrently bool
+	// WITH DATA | WITH NO DATA
+	data string
+}
+
+// Concurrently refresh without locking selects. Requires unique index
+func (r *Refresh) Concurrently() *Refresh {
+	r.concurrently = true
+	return r
+}
+
+// WithData populate view
+func (r *Refresh) WithData() *Refresh {
+	r.data = "WITH DATA"
+	return r
+}
+
+// WithNoData make view unscannable
+func (r *Refresh) WithNoData() *Refresh {
+	r.data = "WITH NO DATA"
+	return r
+}
+
+// IsEmpty check if empty
+func (r *Refresh) IsEmpty() bool {
+	return r == nil || r.name == ""
+}
+
+// String render refresh query
+func (r *Refresh) String() string {
+	if r.IsEmpty() {
+		return ""
+	}
+	b := strings.Builder{}
+	b.WriteString("REFRESH MATERIALIZED VIEW")
+	if r.concurrently {
+		b.WriteString(" CONCURRENTLY")
+	}
+	b.WriteString(" " + r.name)
+	if r.data != "" {
+		b.WriteString(" " + r.data)
+	}
+	return b.String() + ";"
+}
+
+// SQL common sql interface
+func (r *Refresh) SQL() (query string, params []any, returning []any) {
+	query = r.String()
+	return
+}
+
+// RefreshMaterializedView refresh materialized view constructor
+func RefreshMaterializedView(name string) *Refresh {
+	return &Refresh{name: name}
+}
+
+// viewQuery render query with inlined arguments
+func viewQuery(q *Select) (string, error) {
+	if q == nil {
+		return "", nil
+	}
+	return inlineArguments(q.String(), q.GetArguments())
+}
@@ -0,0 +1,111 @@
+package gosql
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestView_String(t *testing.T) {
+	t.Run("view", func(t *testing.T) {
+		q := NewSelect().From("films")
+		q.Columns().Add("*")
+		q.Where().AddExpression("kind = ?", "Comedy")
+		v := CreateView("comedies", q).OrReplace().With("security_barrier = true").CheckOption(ViewCheckLocal)
+		t.Log(v.String())
+		if v.String() != "CREATE OR REPLACE VIEW comedies WITH (security_barrier = true) AS SELECT * FROM films WHERE (kind = 'Comedy') WITH LOCAL CHECK OPTION;" {
+			t.Fatal("wrong view")
+		}
+	})
+	t.Run("recursive_temp", func(t *testing.T) {
+		q := NewSelect()
+		q.Columns().Add("1")
+		v := CreateView("nums_1_100", q).Temp().Recursive().Columns("n").CheckOption("")
+		t.Log(v.String())
+		if v.String() != "CREATE TEMPORARY RECURSIVE VIEW nums_1_100 (n) AS SELECT 1 WITH CHECK OPTION;" {
+			t.Fatal("wrong recursive_temp")
+		}
+	})
+	t.Run("materialized", func(t *testing.T) {
+		q := NewSelect().From("orders")
+		q.Columns().Add("customer_id", "sum(total) AS total")
+		q.Where().AddExpression("created_at >= ? AND status <> ?", time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), "it's \\ done")
+		q.GroupBy("customer_id")
+		m := CreateMaterializedView("order_summary", q).IfNotExists().With("fillfactor = 70").TableSpace("fast").WithNoData()
+		t.Log(m.String())
+		if m.String() != "CREATE MATERIALIZED VIEW IF NOT EXISTS order_summary WITH (fillfactor = 70) TABLESPACE fast AS SELECT customer_id, sum(total) AS total FROM orders WHERE (created_at >= '2024-01-02 03:04:05Z'::timestamptz AND status <> E'it''s \\\\ done') GROUP BY customer_id WITH NO DATA;" {
+			t.Fatal("wrong materialized")
+		}
+	})
+	t.Run("question_mark_literal", func(t *testing.T) {
+		q := NewSelect().From("notes")
+		q.Columns().Add("*")
+		q.Where().AddExpression("note = 'why?'")
+		v := CreateView("why_notes", q)
+		t.Log(v.String())
+		if v.Validate() != nil || v.String() != "CREATE VIEW why_notes AS SELECT * FROM notes WHERE (note = 'why?');" {
+			t.Fatal("wrong question_mark_literal")
+		}
+	})
+	t.Run("any_array", func(t *testing.T) {
+		q := NewSelect().From("orders")
+		q.Columns().Add("*")
+		q.Where().AddExpression("id = ANY(?)", []int{1, 2})
+		q.Where().AddExpression("status = ANY(?)", []string{})
+		v := CreateView("order_view", q)
+		t.Log(v.String())
+		if v.Validate() != nil || v.String() != "CREATE VIEW order_view AS SELECT * FROM orders WHERE (id = ANY(ARRAY[1, 2]) AND status = ANY('{}'));" {
+			t.Fatal("wrong any_array")
+		}
+	})
+	t.Run("rejected", func(t *testing.T) {
+		q := NewSelect().From("orders")
+		q.Columns().Add("*")
+		q.Where().AddExpression("id = ANY(?)", []any{1, struct{}{}})
+		m := CreateMaterializedView("order_summary", q)
+		v := CreateView("order_view", q)
+		if m.Validate() != ErrInlineArgument || v.Validate() != ErrInlineArgument {
+			t.Fatal("must be rejected")
+		}
+		for _, query := range []ISQL{m, v} {
+			func() {
+				defer func() {
+					if recover() != ErrInlineArgument {
+						t.Fatal("must panic with ErrInlineArgument")
+					}
+				}()
+				query.SQL()
+			}()
+		}
+	})
+	t.Run("refresh", func(t *testing.T) {
+		r := RefreshMaterializedView("order_summary").Concurrently().WithData()
+		t.Log(r.String())
+		if r.String() != "REFRESH MATERIALIZED VIEW CONCURRENTLY order_summary WITH DATA;" {
+			t.Fatal("wrong refresh")
+		}
+	})
+}
+
+func TestInlineArguments(t *testing.T) {
+	name := "foo"
+	query, err := inlineArguments("SELECT ?, ?, ?, ?, ?, ?, ?", []any{nil, true, 10, 1.5, &name, []byte{0xde, 0xad}, math.Inf(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(query)
+	if query != "SELECT NULL, TRUE, 10, 1.5, 'foo', E'\\\\xdead'::bytea, 'Infinity'::float8" {
+		t.Fatal("wrong inline")
+	}
+	if _, err = inlineArguments("SELECT ?", nil); err != ErrInlineArgumentsCount {
+		t.Fatal("must be ErrInlineArgumentsCount")
+	}
+	query, err = inlineArguments(`SELECT * FROM notes WHERE note = 'why?' AND "why?" = ? AND data ? 'k' AND data ?| ?`, []any{1, "a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(query)
+	if query != `SELECT * FROM notes WHERE note = 'why?' AND "why?" = 1 AND data ? 'k' AND data ?| 'a'` {
+		t.Fatal("wrong inline of question marks")
+	}
+}
@@ -2,12 +2,22 @@ package gosql
 
 import "strings"
 
+// withQuery with query rendering without collecting arguments
+type withQuery interface {
+	// String get query
+	String() string
+	// GetArguments get query arguments
+	GetArguments() []any
+}
+
 // With SQL
 type with struct {
 	// map of with names
 	keys map[int]string
 	// slice of queries
-	queries []*Select
+	queries []ISQL
+	// options of queries by name
+	options map[string]*withOptions
 	// recursive
 	recursive bool
 }
@@ -26,8 +36,14 @@ func (w *with) Len() int {
 	return len(w.keys)
 }
 
-// Get With
+// Get With select query
 func (w *with) Get(name string) *Select {
+	q, _ := w.GetQuery(name).(*Select)
+	return q
+}
+
+// GetQuery get with query of any type
+func (w *with) GetQuery(name string) ISQL {
 	for j, key := range w.keys {
 		if key == name {
 			return w.queries[j]
@@ -38,6 +54,14 @@ func (w *with) Get(name string) *Select {
 
 // Add With
 func (w *with) Add(name string, qb *Select) *with {
+	if qb == nil {
+		return w
+	}
+	return w.AddQuery(name, qb)
+}
+
+// AddQuery add data modifying with query. Example: DELETE ... RETURNING *
+func (w *with) AddQuery(name string, qb ISQL) *with {
 	if name != "" && qb != nil {
 		w.queries = append(w.queries, qb)
 		if w.keys == nil {
@@ -48,14 +72,55 @@ func (w *with) Add(name string, qb *Select) *with {
 	return w
 }
 
+// Options get options of with query by name
+func (w *with) Options(name string) *withOptions {
+	if w.options == nil {
+		w.options = make(map[string]*withOptions, 2)
+	}
+	if _, ok := w.options[name]; !ok {
+		w.options[name] = &withOptions{}
+	}
+	return w.options[name]
+}
+
+// Validate check search and cycle clauses are used in recursive with
+func (w *with) Validate() error {
+	if w.recursive {
+		return nil
+	}
+	for _, o := range w.options {
+		if o.isRecursive() {
+			return ErrWithNotRecursive
+		}
+	}
+	return nil
+}
+
 // Reset With query
 func (w *with) Reset() *with {
 	w.queries = w.queries[:0]
 	w.keys = make(map[int]string, 2)
+	w.options = nil
 	w.recursive = false
 	return w
 }
 
+// copyFrom replace with queries with src queries
+func (w *with) copyFrom(src *with) *with {
+	w.Reset()
+	for index := range src.queries {
+		w.AddQuery(src.keys[index], src.queries[index])
+	}
+	for name, o := range src.options {
+		if w.options == nil {
+			w.options = make(map[string]*withOptions, len(src.options))
+		}
+		w.options[name] = o
+	}
+	w.recursive = src.recursive
+	return w
+}
+
 // String for with
 func (w *with) String() string {
 	var b strings.Builder
@@ -65,10 +130,17 @@ func (w *with) String() string {
 			b.WriteString("RECURSIVE ")
 		}
 		for index, q := range w.queries {
-			if index < len(w.queries)-1 {
-				b.WriteString(w.keys[index] + " AS (" + q.String() + "),")
+			var query string
+			if wq, ok := q.(withQuery); ok {
+				query = wq.String()
 			} else {
-				b.WriteString(w.keys[index] + " AS (" + q.String() + ")")
+				query, _, _ = q.SQL()
+			}
+			query = strings.TrimSuffix(query, ";")
+			o := w.options[w.keys[index]]
+			b.WriteString(o.name(w.keys[index]) + o.as() + "(" + query + ")" + o.String())
+			if index < len(w.queries)-1 {
+				b.WriteString(",")
 			}
 		}
 	}
@@ -82,7 +154,12 @@ func (w *with) GetArguments() []any {
 	if w.Len() > 0 {
 		params = make([]any, 0, w.Len()*2)
 		for index := range w.queries {
-			params = append(params, w.queries[index].GetArguments()...)
+			if wq, ok := w.queries[index].(withQuery); ok {
+				params = append(params, wq.GetArguments()...)
+				continue
+			}
+			_, args, _ := w.queries[index].SQL()
+			params = append(params, args...)
 		}
 	}
 	return params
@@ -0,0 +1,142 @@
+package gosql
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrWithNotRecursive search or cycle clause used in not recursive with
+var ErrWithNotRecursive = errors.New("gosql: search and cycle clauses require recursive with")
+
+const (
+	// SearchBreadthFirst breadth first search order
+	SearchBreadthFirst = "BREADTH"
+	// SearchDepthFirst depth first search order
+	SearchDepthFirst = "DEPTH"
+)
+
+// withOptions options of with query
+// with_query_name [ ( column_name [, ...] ) ] AS [ [ NOT ] MATERIALIZED ] ( query )
+//
+//	[ SEARCH { BREADTH | DEPTH } FIRST BY column_name [, ...] SET search_seq_col_name ]
+//	[ CYCLE column_name [, ...] SET cycle_mark_col_name [ TO cycle_mark_value DEFAULT cycle_mark_default ] USING cycle_path_col_name ]
+type withOptions struct {
+	// column names
+	columns expression
+	// MATERIALIZED | NOT MATERIALIZED
+	materialized string
+	// BREADTH | DEPTH
+	search string
+	// search by columns
+	searchBy expression
+	// search sequence column
+	searchSet string
+	// cycle columns
+	cycle expression
+	// cycle mark column
+	cycleSet string
+	// cycle mark value
+	cycleMark string
+	// cycle mark default
+	cycleDefault string
+	// cycle path column
+	cycleUsing string
+}
+
+// Columns set column names
+func (o *withOptions) Columns(columns ...string) *withOptions {
+	o.columns.Add(columns...)
+	return o
+}
+
+// Materialized force separate computation of query
+func (o *withOptions) Materialized() *withOptions {
+	o.materialized = "MATERIALIZED"
+	return o
+}
+
+// NotMaterialized allow query to be inlined
+func (o *withOptions) NotMaterialized() *withOptions {
+	o.materialized = "NOT MATERIALIZED"
+	return o
+}
+
+// SearchBreadthFirst order rows breadth first into sequence column
+func (o *withOptions) SearchBreadthFirst(set string, by ...string) *withOptions {
+	return o.setSearch(SearchBreadthFirst, set, by)
+}
+
+// SearchDepthFirst order rows depth first into sequence column
+func (o *withOptions) SearchDepthFirst(set string, by ...string) *withOptions {
+	return o.setSearch(SearchDepthFirst, set, by)
+}
+
+// setSearch set search clause
+func (o *withOptions) setSearch(search string, set string, by []string) *withOptions {
+	o.search = search
+	o.searchSet = set
+	o.searchBy.Reset()
+	o.searchBy.Add(by...)
+	return o
+}
+
+// Cycle detect cycles by columns. Mark column is set to true on cycle, path column holds visited rows
+func (o *withOptions) Cycle(set string, using string, columns ...string) *withOptions {
+	o.cycleSet = set
+	o.cycleUsing = using
+	o.cycle.Reset()
+	o.cycle.Add(columns...)
+	return o
+}
+
+// CycleMark set cycle mark value and default. Example: 'Y', 'N'
+func (o *withOptions) CycleMark(value string, defaultValue string) *withOptions {
+	o.cycleMark = value
+	o.cycleDefault = defaultValue
+	return o
+}
+
+// isRecursive check if options require recursive with
+func (o *withOptions) isRecursive() bool {
+	return o.search != "" || o.cycle.Len() > 0
+}
+
+// IsEmpty check if empty
+func (o *withOptions) IsEmpty() bool {
+	return o == nil || (o.columns.Len() == 0 && o.materialized == "" && !o.isRecursive())
+}
+
+// name render query name with columns
+func (o *withOptions) name(name string) string {
+	if o.IsEmpty() || o.columns.Len() == 0 {
+		return name
+	}
+	return name + "(" + o.columns.String(", ") + ")"
+}
+
+// as render AS keyword with materialization
+func (o *withOptions) as() string {
+	if o.IsEmpty() || o.materialized == "" {
+		return " AS "
+	}
+	return " AS " + o.materialized + " "
+}
+
+// String render search and cycle clauses
+func (o *withOptions) String() string {
+	if o.IsEmpty() {
+		return ""
+	}
+	b := strings.Builder{}
+	if o.search != "" {
+		b.WriteString(" SEARCH " + o.search + " FIRST BY " + o.searchBy.String(", ") + " SET " + o.searchSet)
+	}
+	if o.cycle.Len() > 0 {
+		b.WriteString(" CYCLE " + o.cycle.String(", ") + " SET " + o.cycleSet)
+		if o.cycleMark != "" {
+			b.WriteString(" TO " + o.cycleMark + " DEFAULT " + o.cycleDefault)
+		}
+		b.WriteString(" USING " + o.cycleUsing)
+	}
+	return b.String()
+}
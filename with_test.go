@@ -1 +1,151 @@
 package gosql
+
+import "testing"
+
+func TestWith_AddQuery(t *testing.T) {
+	t.Run("delete_insert", func(t *testing.T) {
+		// WITH moved AS (DELETE FROM tasks WHERE (status = ?) RETURNING *)
+		// INSERT INTO tasks_archive SELECT * FROM moved;
+		d := NewDelete().From("tasks")
+		d.Where().AddExpression("status = ?", "DONE")
+		d.Returning().Add("*")
+
+		moved := NewSelect().From("moved")
+		moved.Columns().Add("*")
+
+		i := NewInsert().Into("tasks_archive").FromSelect(moved)
+		i.With().AddQuery("moved", d)
+
+		query, params, _ := i.SQL()
+		t.Log(query)
+		if query != "WITH moved AS (DELETE FROM tasks WHERE (status = ?) RETURNING *) INSERT INTO tasks_archive SELECT * FROM moved;" {
+			t.Fatal("wrong delete_insert")
+		}
+		if len(params) != 1 || params[0] != "DONE" {
+			t.Fatal("wrong delete_insert params")
+		}
+	})
+	t.Run("arguments_order", func(t *testing.T) {
+		u := NewUpdate().Table("products")
+		u.Set().Append("price = price * ?", 1.1)
+		u.Where().AddExpression("category = ?", "food")
+		u.Returning().Add("id")
+
+		ins := NewInsert().Into("log")
+		ins.Columns().Add("message")
+		ins.Columns().Arg("price")
+		ins.Returning().Add("id")
+
+		last := NewSelect().From("orders")
+		last.Columns().Add("id")
+		last.Where().AddExpression("created_at > ?", "2024-01-01")
+
+		q := NewSelect().From("updated")
+		q.Columns().Add("count(*)")
+		q.Where().AddExpression("id > ?", 10)
+		q.With().AddQuery("updated", u).AddQuery("logged", ins).Add("last", last)
+
+		query, params, _ := q.SQL()
+		t.Log(query)
+		if query != "WITH updated AS (UPDATE products SET price = price * ? WHERE (category = ?) RETURNING id),logged AS (INSERT INTO log (message) VALUES (?) RETURNING id),last AS (SELECT id FROM orders WHERE (created_at > ?)) SELECT count(*) FROM updated WHERE (id > ?)" {
+			t.Fatal("wrong arguments_order")
+		}
+		if len(params) != 5 || params[0] != 1.1 || params[1] != "food" || params[2] != "price" || params[3] != "2024-01-01" || params[4] != 10 {
+			t.Fatal("wrong arguments_order params")
+		}
+		if q.With().Get("updated") != nil || q.With().Get("last") != last || q.With().GetQuery("updated") != u {
+			t.Fatal("wrong get")
+		}
+	})
+}
+
+func TestWith_Options(t *testing.T) {
+	t.Run("materialized", func(t *testing.T) {
+		w := NewSelect().From("big_table")
+		w.Columns().Add("key", "value")
+
+		q := NewSelect().From("w AS w1")
+		q.Columns().Add("*")
+		q.Relate("JOIN w AS w2 ON w1.key = w2.ref")
+		q.Where().AddExpression("w2.key = ?", 123)
+		q.With().Add("w", w).Options("w").Columns("k", "v").NotMaterialized()
+
+		t.Log(q.String())
+		if q.String() != "WITH w(k, v) AS NOT MATERIALIZED (SELECT key, value FROM big_table) SELECT * FROM w AS w1 JOIN w AS w2 ON w1.key = w2.ref WHERE (w2.key = ?)" {
+			t.Fatal("wrong materialized")
+		}
+		if q.With().Validate() != nil {
+			t.Fatal("must be valid")
+		}
+	})
+	t.Run("search_cycle", func(t *testing.T) {
+		base := NewSelect().From("graph g")
+		base.Columns().Add("g.id", "g.link", "g.data")
+		recursive := NewSelect().From("graph g, search_graph sg")
+		recursive.Columns().Add("g.id", "g.link", "g.data")
+		recursive.Where().AddExpression("g.id = sg.link")
+		base.Union(recursive)
+
+		q := NewSelect().From("search_graph")
+		q.Columns().Add("*")
+		q.AddOrder("ordercol")
+		q.With().Add("search_graph", base).Options("search_graph").
+			Columns("id", "link", "data").
+			SearchDepthFirst("ordercol", "id").
+			Cycle("is_cycle", "path", "id")
+
+		if q.With().Validate() != ErrWithNotRecursive {
+			t.Fatal("must be ErrWithNotRecursive")
+		}
+		q.With().Recursive()
+		if q.With().Validate() != nil {
+			t.Fatal("must be valid")
+		}
+		t.Log(q.String())
+		if q.String() != "WITH RECURSIVE search_graph(id, link, data) AS (SELECT g.id, g.link, g.data FROM graph g UNION SELECT g.id, g.link, g.data FROM graph g, search_graph sg WHERE (g.id = sg.link)) SEARCH DEPTH FIRST BY id SET ordercol CYCLE id SET is_cycle USING path SELECT * FROM search_graph ORDER BY ordercol" {
+			t.Fatal("wrong search_cycle")
+		}
+	})
+	t.Run("cycle_mark", func(t *testing.T) {
+		g := NewSelect().From("graph")
+		g.Columns().Add("*")
+		w := with{}
+		w.Recursive().Add("g", g).Options("g").
+			Materialized().
+			Cycle("is_cycle", "path", "id", "link").
+			CycleMark("'Y'", "'N'")
+		t.Log(w.String())
+		if w.String() != "WITH RECURSIVE g AS MATERIALIZED (SELECT * FROM graph) CYCLE id, link SET is_cycle TO 'Y' DEFAULT 'N' USING path" {
+			t.Fatal("wrong cycle_mark")
+		}
+	})
+}
+
+// countQuery count renders of query
+type countQuery struct {
+	*Delete
+	// count of SQL calls
+	calls *int
+}
+
+// SQL common sql interface
+func (c countQuery) SQL() (query string, params []any, returning []any) {
+	*c.calls++
+	return c.Delete.SQL()
+}
+
+func TestWith_Render(t *testing.T) {
+	t.Run("arguments_without_render", func(t *testing.T) {
+		var calls int
+		d := NewDelete().From("tasks")
+		d.Where().AddExpression("status = ?", "DONE")
+		d.Returning().Add("*")
+		w := with{}
+		w.AddQuery("moved", countQuery{Delete: d, calls: &calls})
+		args := w.GetArguments()
+		t.Log(w.String())
+		if calls != 0 || len(args) != 1 || w.String() != "WITH moved AS (DELETE FROM tasks WHERE (status = ?) RETURNING *)" {
+			t.Fatal("with query must be rendered without SQL call")
+		}
+	})
+}